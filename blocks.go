@@ -0,0 +1,202 @@
+package tumblr
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// A page of the blogs a blog has blocked
+type BlockList struct {
+	client ClientInterface
+	Blogs []Blog `json:"blocked_tumblelogs"`
+	name string
+	offset uint
+	limit uint
+}
+
+// Retrieves the blogs a blog has blocked
+func GetBlocks(client ClientInterface, name string, offset, limit uint) (*BlockList, error) {
+	return GetBlocksContext(context.Background(), client, name, offset, limit)
+}
+
+// Retrieves the blogs a blog has blocked, aborting if ctx is done
+func GetBlocksContext(ctx context.Context, client ClientInterface, name string, offset, limit uint) (*BlockList, error) {
+	params := setParamsUint(uint64(offset), url.Values{}, "offset")
+	if limit > 0 {
+		params = setParamsUint(uint64(limit), params, "limit")
+	}
+	response, err := doRequest(ctx, client, http.MethodGet, blogPath("/blog/%s/blocks", name), params)
+	if err != nil {
+		return nil, err
+	}
+	blocks := struct {
+		Response BlockList `json:"response"`
+	}{
+		Response: BlockList{
+			client: client,
+			name: name,
+			offset: offset,
+			limit: limit,
+		},
+	}
+	if err = json.Unmarshal(response.body, &blocks); err != nil {
+		return nil, err
+	}
+	return &blocks.Response, nil
+}
+
+// Retrieves the next page of blocked blogs
+func (b *BlockList) Next() (*BlockList, error) {
+	return b.NextContext(context.Background())
+}
+
+// Retrieves the next page of blocked blogs, aborting if ctx is done
+func (b *BlockList) NextContext(ctx context.Context) (*BlockList, error) {
+	// the API doesn't report a total, so a short page is the last one
+	if len(b.Blogs) < 1 || b.limit > 0 && uint(len(b.Blogs)) < b.limit {
+		return nil, NoNextPageError
+	}
+	return GetBlocksContext(ctx, b.client, b.name, b.offset + uint(len(b.Blogs)), b.limit)
+}
+
+// Retrieves the previous page of blocked blogs
+func (b *BlockList) Prev() (*BlockList, error) {
+	return b.PrevContext(context.Background())
+}
+
+// Retrieves the previous page of blocked blogs, aborting if ctx is done
+func (b *BlockList) PrevContext(ctx context.Context) (*BlockList, error) {
+	if b.offset <= 0 {
+		return nil, NoPrevPageError
+	}
+	limit := b.limit
+	if limit < 1 {
+		limit = uint(len(b.Blogs))
+	}
+	offset := b.offset - limit
+	if limit >= b.offset {
+		offset = 0
+	}
+	return GetBlocksContext(ctx, b.client, b.name, offset, limit)
+}
+
+// Blocks a blog from interacting with the named blog
+func Block(client ClientInterface, name, blocked string) error {
+	return BlockContext(context.Background(), client, name, blocked)
+}
+
+// Blocks a blog from interacting with the named blog, aborting if ctx is done
+func BlockContext(ctx context.Context, client ClientInterface, name, blocked string) error {
+	_, err := doRequest(ctx, client, http.MethodPost, blogPath("/blog/%s/blocks", name), url.Values{
+		"blocked_tumblelog": []string{blocked},
+	})
+	return err
+}
+
+// Blocks the author of an anonymous ask or submission, identified by the post it created
+func BlockByPostId(client ClientInterface, name string, postId uint64) error {
+	return BlockByPostIdContext(context.Background(), client, name, postId)
+}
+
+// Blocks the author of an anonymous ask or submission, aborting if ctx is done
+func BlockByPostIdContext(ctx context.Context, client ClientInterface, name string, postId uint64) error {
+	params := setParamsUint(postId, url.Values{}, "post_id")
+	_, err := doRequest(ctx, client, http.MethodPost, blogPath("/blog/%s/blocks", name), params)
+	return err
+}
+
+// Blocks several blogs at once; force blocks blogs the named blog follows or is followed by as well
+func BlockBulk(client ClientInterface, name string, blocked []string, force bool) error {
+	return BlockBulkContext(context.Background(), client, name, blocked, force)
+}
+
+// Blocks several blogs at once, aborting if ctx is done
+func BlockBulkContext(ctx context.Context, client ClientInterface, name string, blocked []string, force bool) error {
+	params := url.Values{
+		"blocked_tumblelogs": []string{strings.Join(blocked, ",")},
+	}
+	if force {
+		params.Set("force", "true")
+	}
+	_, err := doRequest(ctx, client, http.MethodPost, blogPath("/blog/%s/blocks/bulk", name), params)
+	return err
+}
+
+// Removes a blog from the named blog's blocks
+func Unblock(client ClientInterface, name, blocked string) error {
+	return UnblockContext(context.Background(), client, name, blocked)
+}
+
+// Removes a blog from the named blog's blocks, aborting if ctx is done
+func UnblockContext(ctx context.Context, client ClientInterface, name, blocked string) error {
+	_, err := doRequest(ctx, client, http.MethodDelete, blogPath("/blog/%s/blocks", name), url.Values{
+		"blocked_tumblelog": []string{blocked},
+	})
+	return err
+}
+
+// Removes every anonymous block from the named blog
+func UnblockAnonymous(client ClientInterface, name string) error {
+	return UnblockAnonymousContext(context.Background(), client, name)
+}
+
+// Removes every anonymous block from the named blog, aborting if ctx is done
+func UnblockAnonymousContext(ctx context.Context, client ClientInterface, name string) error {
+	_, err := doRequest(ctx, client, http.MethodDelete, blogPath("/blog/%s/blocks", name), url.Values{
+		"anonymous_only": []string{"true"},
+	})
+	return err
+}
+
+// Retrieves the blogs blocked by the given blog reference
+func (b *BlogRef) GetBlocks() (*BlockList, error) {
+	return b.GetBlocksContext(context.Background())
+}
+
+// Retrieves the blogs blocked by the given blog reference, aborting if ctx is done
+func (b *BlogRef) GetBlocksContext(ctx context.Context) (*BlockList, error) {
+	return GetBlocksContext(ctx, b.client, b.Name, 0, 0)
+}
+
+// Blocks a blog from interacting with the given blog reference
+func (b *BlogRef) Block(blocked string) error {
+	return b.BlockContext(context.Background(), blocked)
+}
+
+// Blocks a blog from interacting with the given blog reference, aborting if ctx is done
+func (b *BlogRef) BlockContext(ctx context.Context, blocked string) error {
+	return BlockContext(ctx, b.client, b.Name, blocked)
+}
+
+// Blocks the anonymous author of the given ask or submission
+func (b *BlogRef) BlockByPostId(postId uint64) error {
+	return b.BlockByPostIdContext(context.Background(), postId)
+}
+
+// Blocks the anonymous author of the given ask or submission, aborting if ctx is done
+func (b *BlogRef) BlockByPostIdContext(ctx context.Context, postId uint64) error {
+	return BlockByPostIdContext(ctx, b.client, b.Name, postId)
+}
+
+// Blocks several blogs from interacting with the given blog reference
+func (b *BlogRef) BlockBulk(blocked []string, force bool) error {
+	return b.BlockBulkContext(context.Background(), blocked, force)
+}
+
+// Blocks several blogs from interacting with the given blog reference, aborting if ctx is done
+func (b *BlogRef) BlockBulkContext(ctx context.Context, blocked []string, force bool) error {
+	return BlockBulkContext(ctx, b.client, b.Name, blocked, force)
+}
+
+// Removes a blog from the given blog reference's blocks
+func (b *BlogRef) Unblock(blocked string) error {
+	return b.UnblockContext(context.Background(), blocked)
+}
+
+// Removes a blog from the given blog reference's blocks, aborting if ctx is done
+func (b *BlogRef) UnblockContext(ctx context.Context, blocked string) error {
+	return UnblockContext(ctx, b.client, b.Name, blocked)
+}
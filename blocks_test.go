@@ -0,0 +1,102 @@
+package tumblr
+
+import (
+	"testing"
+	"errors"
+	"net/http"
+	"net/url"
+)
+
+func getBlocksString(names ...string) string {
+	blogs := []Blog{}
+	for _, name := range names {
+		blogs = append(blogs, Blog{Name: name})
+	}
+	return jsonStringify(map[string]interface{}{
+		"response": map[string]interface{}{
+			"blocked_tumblelogs": blogs,
+		},
+	})
+}
+
+func TestGetBlocks(t *testing.T) {
+	client := newTestClient(getBlocksString("a", "b"), nil)
+	path := blogPath("/blog/%s/blocks", "david")
+	client.confirmExpectedSet = expectClientCallParams(t, "GetBlocks", http.MethodGet, path, url.Values{
+		"offset": []string{"0"},
+		"limit": []string{"2"},
+	})
+	blocks, err := GetBlocks(client, "david", 0, 2)
+	if err != nil || len(blocks.Blogs) != 2 || blocks.Blogs[1].Name != "b" {
+		t.Fatal("Failed to get blocks", err)
+	}
+	client.confirmExpectedSet = expectClientCallParams(t, "BlockList.Next", http.MethodGet, path, url.Values{
+		"offset": []string{"2"},
+		"limit": []string{"2"},
+	})
+	next, err := blocks.Next()
+	if err != nil {
+		t.Fatal("Full page should have a next page", err)
+	}
+	client.confirmExpectedSet = expectClientCallParams(t, "BlockList.Prev", http.MethodGet, path, url.Values{
+		"offset": []string{"0"},
+		"limit": []string{"2"},
+	})
+	if _, err = next.Prev(); err != nil {
+		t.Fatal("Failed to get previous page", err)
+	}
+	if _, err = blocks.Prev(); err != NoPrevPageError {
+		t.Fatal("First page should have no previous page")
+	}
+	blocks.Blogs = blocks.Blogs[:1]
+	if _, err = blocks.Next(); err != NoNextPageError {
+		t.Fatal("Short page should have no next page")
+	}
+}
+
+func TestGetBlocksError(t *testing.T) {
+	clientErr := errors.New("Client error")
+	if _, err := NewBlogRef(newTestClient("", clientErr), "david").GetBlocks(); err != clientErr {
+		t.Fatal("Client error should be returned")
+	}
+	if _, err := GetBlocks(newTestClient("{", nil), "david", 0, 0); err == nil {
+		t.Fatal("Invalid JSON should generate an error")
+	}
+}
+
+func TestBlock(t *testing.T) {
+	client := newTestClient("{}", nil)
+	blog := NewBlogRef(client, "david")
+	path := blogPath("/blog/%s/blocks", "david")
+	client.confirmExpectedSet = expectClientCallParams(t, "Block", http.MethodPost, path, url.Values{
+		"blocked_tumblelog": []string{"spam"},
+	})
+	if err := blog.Block("spam"); err != nil {
+		t.Fatal("Failed to block", err)
+	}
+	client.confirmExpectedSet = expectClientCallParams(t, "BlockByPostId", http.MethodPost, path, url.Values{
+		"post_id": []string{"1986"},
+	})
+	if err := blog.BlockByPostId(1986); err != nil {
+		t.Fatal("Failed to block by post id", err)
+	}
+	client.confirmExpectedSet = expectClientCallParams(t, "BlockBulk", http.MethodPost, blogPath("/blog/%s/blocks/bulk", "david"), url.Values{
+		"blocked_tumblelogs": []string{"a,b"},
+		"force": []string{"true"},
+	})
+	if err := blog.BlockBulk([]string{"a", "b"}, true); err != nil {
+		t.Fatal("Failed to bulk block", err)
+	}
+	client.confirmExpectedSet = expectClientCallParams(t, "Unblock", http.MethodDelete, path, url.Values{
+		"blocked_tumblelog": []string{"spam"},
+	})
+	if err := blog.Unblock("spam"); err != nil {
+		t.Fatal("Failed to unblock", err)
+	}
+	client.confirmExpectedSet = expectClientCallParams(t, "UnblockAnonymous", http.MethodDelete, path, url.Values{
+		"anonymous_only": []string{"true"},
+	})
+	if err := UnblockAnonymous(client, "david"); err != nil {
+		t.Fatal("Failed to unblock anonymous", err)
+	}
+}
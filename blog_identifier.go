@@ -0,0 +1,157 @@
+package tumblr
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Identifies a blog by its name, hostname (including custom domains) or UUID.
+// At least one field is set; a blog on a custom domain may have only its Host.
+type BlogIdentifier struct {
+	// Blog name, eg `staff`
+	Name string
+	// Blog hostname, eg `staff.tumblr.com` or `www.example.com`
+	Host string
+	// Blog UUID, eg `t:0aY0xL2Fi1OFJg4YxpmegQ`
+	Uuid string
+}
+
+// Hosts serving blogs at paths such as tumblr.com/staff rather than on their own subdomain
+var tumblrHosts = map[string]bool{
+	"tumblr.com": true,
+	"www.tumblr.com": true,
+}
+
+// First path segments on tumblr.com which are not blog names
+var reservedTumblrPaths = map[string]bool{
+	"dashboard": true,
+	"explore": true,
+	"tagged": true,
+	"search": true,
+	"likes": true,
+	"following": true,
+	"settings": true,
+	"login": true,
+	"register": true,
+	"inbox": true,
+	"new": true,
+	"reblog": true,
+}
+
+// Parses a blog name (`staff`), hostname (`staff.tumblr.com`, `www.example.com`),
+// UUID (`t:0aY0xL2Fi1OFJg4YxpmegQ`) or URL (`https://staff.tumblr.com/post/123`, `https://www.tumblr.com/staff`)
+func ParseBlogIdentifier(s string) (BlogIdentifier, error) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "@")
+	if s == "" {
+		return BlogIdentifier{}, errors.New("No blog identifier provided")
+	}
+	if strings.HasPrefix(s, "t:") {
+		if len(s) < 3 || strings.ContainsAny(s, "/?# ") {
+			return BlogIdentifier{}, fmt.Errorf("Invalid blog UUID %s", s)
+		}
+		return BlogIdentifier{Uuid: s}, nil
+	}
+	if !strings.Contains(s, "://") && strings.ContainsAny(s, "/?#") {
+		s = "https://" + s
+	}
+	if strings.Contains(s, "://") {
+		return parseBlogUrl(s)
+	}
+	if strings.Contains(s, ".") {
+		return blogIdentifierFromHost(s, "")
+	}
+	if !isBlogName(s) {
+		return BlogIdentifier{}, fmt.Errorf("Invalid blog name %s", s)
+	}
+	return BlogIdentifier{Name: s}, nil
+}
+
+// Parses the blog out of a URL on the blog's own host or under tumblr.com
+func parseBlogUrl(s string) (BlogIdentifier, error) {
+	u, err := url.Parse(s)
+	if err != nil {
+		return BlogIdentifier{}, err
+	}
+	if u.Hostname() == "" {
+		return BlogIdentifier{}, fmt.Errorf("No host in blog URL %s", s)
+	}
+	return blogIdentifierFromHost(u.Hostname(), u.Path)
+}
+
+// Identifies the blog served at host; for tumblr.com itself the blog name is taken from path instead
+func blogIdentifierFromHost(host, path string) (BlogIdentifier, error) {
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	if tumblrHosts[host] {
+		segments := tumblrBlogSegments(path)
+		if len(segments) < 1 || reservedTumblrPaths[segments[0]] || !isBlogName(segments[0]) {
+			return BlogIdentifier{}, fmt.Errorf("No blog found in URL path %s", path)
+		}
+		return BlogIdentifier{Name: segments[0]}, nil
+	}
+	if strings.HasSuffix(host, ".tumblr.com") {
+		name := strings.TrimSuffix(host, ".tumblr.com")
+		if !isBlogName(name) {
+			return BlogIdentifier{}, fmt.Errorf("Invalid blog hostname %s", host)
+		}
+		return BlogIdentifier{Name: name, Host: host}, nil
+	}
+	return BlogIdentifier{Host: host}, nil
+}
+
+// Segments of a tumblr.com path starting from the blog name, skipping the blog/ or blog/view/ prefix
+// of the older tumblr.com/blog/view/staff and tumblr.com/blog/staff shapes of tumblr.com/staff
+func tumblrBlogSegments(path string) []string {
+	segments := strings.FieldsFunc(path, func(r rune) bool {
+		return r == '/'
+	})
+	if len(segments) > 0 && segments[0] == "blog" {
+		segments = segments[1:]
+		if len(segments) > 0 && segments[0] == "view" {
+			segments = segments[1:]
+		}
+	}
+	return segments
+}
+
+// Whether s contains only the characters allowed in blog names
+func isBlogName(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_') {
+			return false
+		}
+	}
+	return true
+}
+
+// Identifier used in API paths: the UUID, hostname or name, in that order of preference.
+// Pass it as the name of any blog function, such as GetPosts(client, id.String(), params) or NewBlogRef(client, id.String()).
+func (b BlogIdentifier) String() string {
+	if b.Uuid != "" {
+		return b.Uuid
+	}
+	if b.Host != "" {
+		return b.Host
+	}
+	return b.Name
+}
+
+// Hostname of the blog, derived from its name if needed; a blog known only by UUID has none, so its UUID is returned
+func (b BlogIdentifier) Hostname() string {
+	if b.Host != "" {
+		return b.Host
+	}
+	if b.Name != "" {
+		return b.Name + ".tumblr.com"
+	}
+	return b.Uuid
+}
+
+// Parses the BlogRef's name as a BlogIdentifier
+func (b *BlogRef) Identifier() (BlogIdentifier, error) {
+	return ParseBlogIdentifier(b.Name)
+}
@@ -0,0 +1,101 @@
+package tumblr
+
+import (
+	"testing"
+	"net/url"
+	"net/http"
+)
+
+func TestParseBlogIdentifier(t *testing.T) {
+	cases := map[string]BlogIdentifier{
+		"staff": BlogIdentifier{Name: "staff"},
+		" @staff ": BlogIdentifier{Name: "staff"},
+		"staff.tumblr.com": BlogIdentifier{Name: "staff", Host: "staff.tumblr.com"},
+		"Staff.Tumblr.com": BlogIdentifier{Name: "staff", Host: "staff.tumblr.com"},
+		"www.example.com": BlogIdentifier{Host: "www.example.com"},
+		"t:0aY0xL2Fi1OFJg4YxpmegQ": BlogIdentifier{Uuid: "t:0aY0xL2Fi1OFJg4YxpmegQ"},
+		"https://staff.tumblr.com/post/123/slug": BlogIdentifier{Name: "staff", Host: "staff.tumblr.com"},
+		"staff.tumblr.com/post/123": BlogIdentifier{Name: "staff", Host: "staff.tumblr.com"},
+		"https://www.tumblr.com/staff/123": BlogIdentifier{Name: "staff"},
+		"https://tumblr.com/staff": BlogIdentifier{Name: "staff"},
+		"https://www.tumblr.com/blog/view/staff/123": BlogIdentifier{Name: "staff"},
+		"http://www.example.com/post/123": BlogIdentifier{Host: "www.example.com"},
+	}
+	for input, expected := range cases {
+		if id, err := ParseBlogIdentifier(input); err != nil || id != expected {
+			t.Errorf("Expected %q to parse as %+v, got %+v (%v)", input, expected, id, err)
+		}
+	}
+	for _, input := range []string{"", "t:", "bad name", "https://www.tumblr.com/", "https://www.tumblr.com/dashboard", "https:///post/1"} {
+		if _, err := ParseBlogIdentifier(input); err == nil {
+			t.Errorf("Expected %q to fail to parse", input)
+		}
+	}
+}
+
+func TestBlogIdentifierForms(t *testing.T) {
+	name := BlogIdentifier{Name: "staff"}
+	host := BlogIdentifier{Name: "staff", Host: "staff.tumblr.com"}
+	uuid := BlogIdentifier{Uuid: "t:abc"}
+	if name.String() != "staff" || host.String() != "staff.tumblr.com" || uuid.String() != "t:abc" {
+		t.Fatal("String should give the identifier used in API paths")
+	}
+	if name.Hostname() != "staff.tumblr.com" || host.Hostname() != "staff.tumblr.com" || uuid.Hostname() != "t:abc" {
+		t.Fatal("Hostname should derive the host from the name")
+	}
+}
+
+func TestBlogPathIdentifiers(t *testing.T) {
+	cases := map[string]string{
+		"david": "/blog/david/info",
+		"david.tumblr.com": "/blog/david.tumblr.com/info",
+		"t:abc": "/blog/t:abc/info",
+		"https://david.tumblr.com/post/1": "/blog/david.tumblr.com/info",
+		"https://www.tumblr.com/david/1": "/blog/david/info",
+	}
+	for name, expected := range cases {
+		if path := blogPath("/blog/%s/info", name); path != expected {
+			t.Errorf("Expected %s, got %s", expected, path)
+		}
+	}
+	if normalizeBlogName("t:abc") != "t:abc" || normalizeBlogName("david") != "david.tumblr.com" {
+		t.Fatal("UUIDs should not be given a tumblr.com suffix")
+	}
+}
+
+func TestNewBlogRefWithIdentifier(t *testing.T) {
+	client := newTestClient("{}", nil)
+	id, _ := ParseBlogIdentifier("https://www.tumblr.com/david/1986")
+	ref := NewBlogRef(client, id.String())
+	client.confirmExpectedSet = expectClientCallParams(t, "GetBlogInfo", http.MethodGet, "/blog/david/info", url.Values{})
+	if _, err := ref.GetInfo(); err != nil {
+		t.Fatal("Blog info should be requested", err)
+	}
+	if parsed, err := ref.Identifier(); err != nil || parsed != id {
+		t.Fatal("BlogRef should parse its name as an identifier")
+	}
+}
+
+func TestBlogFunctionsAcceptIdentifierStrings(t *testing.T) {
+	uuid := BlogIdentifier{Uuid: "t:abc"}
+	if path := blogPath("/blog/%s/info", uuid.String()); path != "/blog/t:abc/info" {
+		t.Fatal("blogPath should accept identifiers", path)
+	}
+	client := newTestClient(`{"response": {"total_users": 0, "users": []}}`, nil)
+	client.confirmExpectedSet = expectClientCallParams(t, "Follow", http.MethodPost, "/user/follow", url.Values{"url": []string{"t:abc"}})
+	if err := Follow(client, uuid.String()); err != nil {
+		t.Fatal("Follow should accept identifiers", err)
+	}
+	client.confirmExpectedSet = expectClientCallParams(t, "Unfollow", http.MethodPost, "/user/unfollow", url.Values{"url": []string{"david.tumblr.com"}})
+	if err := Unfollow(client, BlogIdentifier{Name: "david"}.String()); err != nil {
+		t.Fatal("Unfollow should accept identifiers", err)
+	}
+	client.confirmExpectedSet = expectClientCallParams(t, "GetFollowers", http.MethodGet, "/blog/t:abc/followers", url.Values{"offset": []string{"0"}, "limit": []string{"0"}})
+	followers, err := GetFollowers(client, uuid.String(), 0, 0)
+	if err != nil || followers.name != "t:abc" {
+		t.Fatal("GetFollowers should accept identifiers", err)
+	}
+	if ref := NewBlogRef(client, uuid.String()); ref.Name != "t:abc" {
+		t.Fatal("NewBlogRef should accept identifiers", ref.Name)
+	}
+}
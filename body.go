@@ -0,0 +1,269 @@
+package tumblr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/textproto"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Request body which can't be expressed as url.Values, such as JSON or multipart payloads
+type RequestBody interface {
+	// Value for the request's Content-Type header
+	ContentType() string
+	// Returns a reader over the body's contents; called again for every attempt at sending the request
+	Open() (io.ReadCloser, error)
+}
+
+// Clients able to send arbitrary request bodies should implement this interface as well
+type BodyClientInterface interface {
+	// Issue a request to Tumblr API with the given body, aborted if ctx is done
+	SendBody(ctx context.Context, method, endpoint string, body RequestBody) (Response, error)
+}
+
+// Error returned when a request needs a body but the client does not implement BodyClientInterface
+var BodyNotSupportedError error = errors.New("Client does not support request bodies.")
+
+// JSON encoded request body
+type JSONBody struct {
+	data []byte
+}
+
+// Encodes the value as a JSON request body
+func NewJSONBody(value interface{}) (*JSONBody, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	return &JSONBody{data: data}, nil
+}
+
+// JSON bodies are sent as application/json
+func (b *JSONBody) ContentType() string {
+	return "application/json"
+}
+
+// Returns a reader over the encoded JSON, fresh for each attempt
+func (b *JSONBody) Open() (io.ReadCloser, error) {
+	return io.NopCloser(bytes.NewReader(b.data)), nil
+}
+
+// Returns the encoded JSON
+func (b *JSONBody) Bytes() []byte {
+	return b.data
+}
+
+// File to upload as part of a multipart request
+type MediaFile struct {
+	Filename string
+	// MIME type of the file
+	ContentType string
+	// Name referencing the file from an NPF media object, only needed for NPF posts
+	Identifier string
+	open func() (io.ReadCloser, error)
+}
+
+// Error returned when a MediaFile created from a reader which can't seek must be sent a second time
+var MediaFileConsumedError error = errors.New("Media file has already been read.")
+
+// Error returned when a nil MediaFile is passed to be uploaded
+var NilMediaFileError error = errors.New("Media file is nil.")
+
+// Creates a MediaFile from the reader, which is streamed rather than buffered. Unless the reader
+// is also an io.Seeker, it can only be sent once, so retrying the request will fail with MediaFileConsumedError.
+func NewMediaFile(reader io.Reader, filename, contentType string) *MediaFile {
+	opened := false
+	return &MediaFile{
+		Filename: filename,
+		ContentType: contentType,
+		open: func() (io.ReadCloser, error) {
+			if opened {
+				seeker, ok := reader.(io.Seeker)
+				if !ok {
+					return nil, MediaFileConsumedError
+				}
+				if _, err := seeker.Seek(0, io.SeekStart); err != nil {
+					return nil, err
+				}
+			}
+			opened = true
+			return io.NopCloser(reader), nil
+		},
+	}
+}
+
+// Creates a MediaFile which reads the file at path each time it's sent, guessing its content type from the extension
+func OpenMediaFile(path string) (*MediaFile, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return nil, fmt.Errorf("%s is a directory", path)
+	}
+	contentType := mime.TypeByExtension(filepath.Ext(path))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	return &MediaFile{
+		Filename: filepath.Base(path),
+		ContentType: contentType,
+		open: func() (io.ReadCloser, error) {
+			return os.Open(path)
+		},
+	}, nil
+}
+
+// Sets the identifier referencing the file from NPF media objects, returning the file for chaining
+func (f *MediaFile) WithIdentifier(identifier string) *MediaFile {
+	f.Identifier = identifier
+	return f
+}
+
+// Media object referencing the file by its identifier, for use in NPF image, audio and video blocks
+func (f *MediaFile) MediaObject() MediaObject {
+	return MediaObject{Type: f.ContentType, Identifier: f.Identifier}
+}
+
+// Multipart form request body whose files are streamed as the request is sent
+type MultipartBody struct {
+	boundary string
+	parts []multipartPart
+}
+
+// A single field or file of a MultipartBody
+type multipartPart struct {
+	name string
+	contentType string
+	value []byte
+	file *MediaFile
+}
+
+// Creates an empty multipart body
+func NewMultipartBody() *MultipartBody {
+	return &MultipartBody{boundary: multipart.NewWriter(io.Discard).Boundary()}
+}
+
+// Adds a plain form field
+func (b *MultipartBody) AddField(name, value string) {
+	b.parts = append(b.parts, multipartPart{name: name, value: []byte(value)})
+}
+
+// Adds a form field for each of the params' values
+func (b *MultipartBody) AddParams(params url.Values) {
+	for name, values := range params {
+		for _, value := range values {
+			b.AddField(name, value)
+		}
+	}
+}
+
+// Adds a field containing the value encoded as JSON
+func (b *MultipartBody) AddJSON(name string, value interface{}) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	b.parts = append(b.parts, multipartPart{name: name, contentType: "application/json", value: data})
+	return nil
+}
+
+// Adds a file, which is not read until the body is sent
+func (b *MultipartBody) AddFile(name string, file *MediaFile) {
+	b.parts = append(b.parts, multipartPart{name: name, file: file})
+}
+
+// Multipart content type, including the boundary separating parts
+func (b *MultipartBody) ContentType() string {
+	return "multipart/form-data; boundary=" + b.boundary
+}
+
+// Returns a reader producing the body as it's read, so files are never held in memory in their entirety
+func (b *MultipartBody) Open() (io.ReadCloser, error) {
+	reader, writer := io.Pipe()
+	go func() {
+		writer.CloseWithError(b.write(writer))
+	}()
+	return reader, nil
+}
+
+// Writes every part to w
+func (b *MultipartBody) write(w io.Writer) error {
+	mw := multipart.NewWriter(w)
+	if err := mw.SetBoundary(b.boundary); err != nil {
+		return err
+	}
+	for _, part := range b.parts {
+		if err := part.write(mw); err != nil {
+			return err
+		}
+	}
+	return mw.Close()
+}
+
+// Writes the part's headers and contents, opening and closing its file if it has one
+func (p multipartPart) write(mw *multipart.Writer) error {
+	header := textproto.MIMEHeader{}
+	disposition := fmt.Sprintf(`form-data; name="%s"`, escapeQuotes(p.name))
+	contentType := p.contentType
+	if p.file != nil {
+		disposition += fmt.Sprintf(`; filename="%s"`, escapeQuotes(p.file.Filename))
+		contentType = p.file.ContentType
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+	}
+	header.Set("Content-Disposition", disposition)
+	if contentType != "" {
+		header.Set("Content-Type", contentType)
+	}
+	w, err := mw.CreatePart(header)
+	if err != nil {
+		return err
+	}
+	if p.file == nil {
+		_, err = w.Write(p.value)
+		return err
+	}
+	if p.file.open == nil {
+		return errors.New("Media file has no contents; create it with NewMediaFile or OpenMediaFile")
+	}
+	file, err := p.file.open()
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	_, err = io.Copy(w, file)
+	return err
+}
+
+// Escapes quotes and backslashes for use in a Content-Disposition header, as mime/multipart does
+func escapeQuotes(s string) string {
+	return strings.NewReplacer("\\", "\\\\", `"`, "\\\"").Replace(s)
+}
+
+// Issues a request with a body through the client, which must implement BodyClientInterface.
+// Responses whose meta status indicates failure are returned along with an *APIError.
+func doBodyRequest(ctx context.Context, client ClientInterface, method, endpoint string, body RequestBody) (Response, error) {
+	c, ok := client.(BodyClientInterface)
+	if !ok {
+		return Response{}, BodyNotSupportedError
+	}
+	if err := ctx.Err(); err != nil {
+		return Response{}, err
+	}
+	response, err := c.SendBody(ctx, method, endpoint, body)
+	if err == nil {
+		err = checkResponse(response)
+	}
+	return response, err
+}
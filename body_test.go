@@ -0,0 +1,148 @@
+package tumblr
+
+import (
+	"testing"
+	"context"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func TestJSONBody(t *testing.T) {
+	body, err := NewJSONBody(map[string]string{"key": "value"})
+	if err != nil {
+		t.Fatal("Failed to encode body", err)
+	}
+	for i := 0; i < 2; i++ {
+		reader, err := body.Open()
+		if err != nil {
+			t.Fatal("Failed to open body", err)
+		}
+		if data, _ := io.ReadAll(reader); string(data) != `{"key":"value"}` {
+			t.Fatal("Body should be readable on every attempt", string(data))
+		}
+		reader.Close()
+	}
+	if _, err = NewJSONBody(func() {}); err == nil {
+		t.Fatal("Unencodable values should generate an error")
+	}
+}
+
+func TestDoBodyRequest(t *testing.T) {
+	client := newTestClient(`{"meta": {"status": 400, "msg": "Bad Request"}}`, nil)
+	body, _ := NewJSONBody(nil)
+	client.confirmExpectedSet = expectClientCallParams(t, "doBodyRequest", http.MethodPost, "/path", url.Values{})
+	if _, err := doBodyRequest(context.Background(), client, http.MethodPost, "/path", body); err == nil {
+		t.Fatal("Failed meta status should generate an error")
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	client.confirmExpectedSet = func(method, path string, params url.Values) {
+		t.Fatal("Request should not be issued with a cancelled context")
+	}
+	if _, err := doBodyRequest(ctx, client, http.MethodPost, "/path", body); err != context.Canceled {
+		t.Fatal("Cancelled context error should be returned", err)
+	}
+}
+
+// Reads every part of the body, keyed by part name
+func readMultipart(t *testing.T, body *MultipartBody) map[string]*multipartTestPart {
+	_, params, err := mime.ParseMediaType(body.ContentType())
+	if err != nil || params["boundary"] == "" {
+		t.Fatal("Content type should carry the boundary", body.ContentType())
+	}
+	reader, err := body.Open()
+	if err != nil {
+		t.Fatal("Failed to open body", err)
+	}
+	defer reader.Close()
+	parts := map[string]*multipartTestPart{}
+	mr := multipart.NewReader(reader, params["boundary"])
+	for {
+		part, err := mr.NextPart()
+		if err == io.EOF {
+			return parts
+		}
+		if err != nil {
+			t.Fatal("Failed to read part", err)
+		}
+		data, _ := io.ReadAll(part)
+		parts[part.FormName()] = &multipartTestPart{part.FileName(), part.Header.Get("Content-Type"), string(data)}
+	}
+}
+
+type multipartTestPart struct {
+	filename string
+	contentType string
+	data string
+}
+
+func TestMultipartBody(t *testing.T) {
+	body := NewMultipartBody()
+	body.AddParams(url.Values{"type": []string{"photo"}})
+	if err := body.AddJSON("json", map[string]int{"a": 1}); err != nil {
+		t.Fatal("Failed to add JSON", err)
+	}
+	body.AddFile("data[0]", NewMediaFile(strings.NewReader("image data"), `cat "1".jpg`, "image/jpeg"))
+	if !strings.HasPrefix(body.ContentType(), "multipart/form-data; boundary=") {
+		t.Fatal("Unexpected content type", body.ContentType())
+	}
+	parts := readMultipart(t, body)
+	if len(parts) != 3 || parts["type"].data != "photo" {
+		t.Fatal("Fields should be written as parts", parts)
+	}
+	if parts["json"].data != `{"a":1}` || parts["json"].contentType != "application/json" {
+		t.Fatal("JSON part not written correctly", parts["json"])
+	}
+	file := parts["data[0]"]
+	if file.data != "image data" || file.filename != `cat "1".jpg` || file.contentType != "image/jpeg" {
+		t.Fatal("File part not written correctly", file)
+	}
+	// strings.Reader can seek, so the body may be sent again
+	if again := readMultipart(t, body); again["data[0]"].data != "image data" {
+		t.Fatal("Seekable files should be re-read from the start")
+	}
+}
+
+func TestMultipartBodyFileError(t *testing.T) {
+	body := NewMultipartBody()
+	body.AddFile("data", NewMediaFile(io.MultiReader(strings.NewReader("once")), "a.txt", "text/plain"))
+	reader, _ := body.Open()
+	io.ReadAll(reader)
+	reader, _ = body.Open()
+	if _, err := io.ReadAll(reader); err != MediaFileConsumedError {
+		t.Fatal("Readers which can't seek should only be sent once", err)
+	}
+}
+
+func TestOpenMediaFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "video.mp4")
+	if err := os.WriteFile(path, []byte("video data"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	file, err := OpenMediaFile(path)
+	if err != nil {
+		t.Fatal("Failed to open media file", err)
+	}
+	if file.Filename != "video.mp4" || file.ContentType != "video/mp4" {
+		t.Fatal("Filename and content type should be taken from the path", file)
+	}
+	if object := file.WithIdentifier("clip").MediaObject(); object.Identifier != "clip" || object.Type != "video/mp4" {
+		t.Fatal("Media object should reference the file", object)
+	}
+	body := NewMultipartBody()
+	body.AddFile("clip", file)
+	for i := 0; i < 2; i++ {
+		if parts := readMultipart(t, body); parts["clip"].data != "video data" {
+			t.Fatal("Files from disk should be re-read on every attempt")
+		}
+	}
+	if _, err = OpenMediaFile(filepath.Join(t.TempDir(), "missing.mp4")); err == nil {
+		t.Fatal("Missing files should generate an error")
+	}
+}
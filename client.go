@@ -1,7 +1,9 @@
 package tumblr
 
 import (
+	"context"
 	"net/url"
+	"net/http"
 	"encoding/json"
 	"fmt"
 	"errors"
@@ -28,6 +30,145 @@ type ClientInterface interface {
 	DeleteWithParams(endpoint string, params url.Values) (Response, error)
 }
 
+// Clients which can cancel or time out requests should implement this interface as well
+type ContextClientInterface interface {
+	ClientInterface
+	// Issue GET request to Tumblr API, aborted if ctx is done
+	GetContext(ctx context.Context, endpoint string) (Response, error)
+	// Issue GET request to Tumblr API with param values, aborted if ctx is done
+	GetWithParamsContext(ctx context.Context, endpoint string, params url.Values) (Response, error)
+	// Issue POST request to Tumblr API, aborted if ctx is done
+	PostContext(ctx context.Context, endpoint string) (Response, error)
+	// Issue POST request to Tumblr API with param values, aborted if ctx is done
+	PostWithParamsContext(ctx context.Context, endpoint string, params url.Values) (Response, error)
+	// Issue PUT request to Tumblr API, aborted if ctx is done
+	PutContext(ctx context.Context, endpoint string) (Response, error)
+	// Issue PUT request to Tumblr API with param values, aborted if ctx is done
+	PutWithParamsContext(ctx context.Context, endpoint string, params url.Values) (Response, error)
+	// Issue DELETE request to Tumblr API, aborted if ctx is done
+	DeleteContext(ctx context.Context, endpoint string) (Response, error)
+	// Issue DELETE request to Tumblr API with param values, aborted if ctx is done
+	DeleteWithParamsContext(ctx context.Context, endpoint string, params url.Values) (Response, error)
+}
+
+// Returns the client as a ContextClientInterface, wrapping it if it does not support contexts natively.
+// A wrapped client cannot abort a request in flight, but the call returns as soon as the context is done.
+func NewContextClient(client ClientInterface) ContextClientInterface {
+	if c, ok := client.(ContextClientInterface); ok {
+		return c
+	}
+	return &contextClientAdapter{ClientInterface: client}
+}
+
+// Adapter allowing a plain ClientInterface to be used as a ContextClientInterface
+type contextClientAdapter struct {
+	ClientInterface
+}
+
+// Runs the request unless the context is already done, returning early if the context finishes first
+func (a *contextClientAdapter) call(ctx context.Context, request func() (Response, error)) (Response, error) {
+	if err := ctx.Err(); err != nil {
+		return Response{}, err
+	}
+	// contexts which can never be cancelled don't need the extra goroutine
+	if ctx.Done() == nil {
+		return request()
+	}
+	type result struct {
+		response Response
+		err error
+	}
+	done := make(chan result, 1)
+	go func() {
+		response, err := request()
+		done <- result{response, err}
+	}()
+	select {
+	case r := <-done:
+		return r.response, r.err
+	case <-ctx.Done():
+		return Response{}, ctx.Err()
+	}
+}
+
+// Issue GET request through the wrapped client, returning early if ctx is done
+func (a *contextClientAdapter) GetContext(ctx context.Context, endpoint string) (Response, error) {
+	return a.call(ctx, func() (Response, error) { return a.Get(endpoint) })
+}
+
+// Issue GET request with param values through the wrapped client, returning early if ctx is done
+func (a *contextClientAdapter) GetWithParamsContext(ctx context.Context, endpoint string, params url.Values) (Response, error) {
+	return a.call(ctx, func() (Response, error) { return a.GetWithParams(endpoint, params) })
+}
+
+// Issue POST request through the wrapped client, returning early if ctx is done
+func (a *contextClientAdapter) PostContext(ctx context.Context, endpoint string) (Response, error) {
+	return a.call(ctx, func() (Response, error) { return a.Post(endpoint) })
+}
+
+// Issue POST request with param values through the wrapped client, returning early if ctx is done
+func (a *contextClientAdapter) PostWithParamsContext(ctx context.Context, endpoint string, params url.Values) (Response, error) {
+	return a.call(ctx, func() (Response, error) { return a.PostWithParams(endpoint, params) })
+}
+
+// Issue PUT request through the wrapped client, returning early if ctx is done
+func (a *contextClientAdapter) PutContext(ctx context.Context, endpoint string) (Response, error) {
+	return a.call(ctx, func() (Response, error) { return a.Put(endpoint) })
+}
+
+// Issue PUT request with param values through the wrapped client, returning early if ctx is done
+func (a *contextClientAdapter) PutWithParamsContext(ctx context.Context, endpoint string, params url.Values) (Response, error) {
+	return a.call(ctx, func() (Response, error) { return a.PutWithParams(endpoint, params) })
+}
+
+// Issue DELETE request through the wrapped client, returning early if ctx is done
+func (a *contextClientAdapter) DeleteContext(ctx context.Context, endpoint string) (Response, error) {
+	return a.call(ctx, func() (Response, error) { return a.Delete(endpoint) })
+}
+
+// Issue DELETE request with param values through the wrapped client, returning early if ctx is done
+func (a *contextClientAdapter) DeleteWithParamsContext(ctx context.Context, endpoint string, params url.Values) (Response, error) {
+	return a.call(ctx, func() (Response, error) { return a.DeleteWithParams(endpoint, params) })
+}
+
+// Issues a request through the context-aware variant of the client; nil params uses the param-less method.
+// Responses whose meta status indicates failure are returned along with an *APIError.
+func doRequest(ctx context.Context, client ClientInterface, method, endpoint string, params url.Values) (Response, error) {
+	response, err := sendRequest(ctx, client, method, endpoint, params)
+	if err == nil {
+		err = checkResponse(response)
+	}
+	return response, err
+}
+
+// Dispatches to the client method matching the request method
+func sendRequest(ctx context.Context, client ClientInterface, method, endpoint string, params url.Values) (Response, error) {
+	c := NewContextClient(client)
+	switch method {
+	case http.MethodGet:
+		if params == nil {
+			return c.GetContext(ctx, endpoint)
+		}
+		return c.GetWithParamsContext(ctx, endpoint, params)
+	case http.MethodPost:
+		if params == nil {
+			return c.PostContext(ctx, endpoint)
+		}
+		return c.PostWithParamsContext(ctx, endpoint, params)
+	case http.MethodPut:
+		if params == nil {
+			return c.PutContext(ctx, endpoint)
+		}
+		return c.PutWithParamsContext(ctx, endpoint, params)
+	case http.MethodDelete:
+		if params == nil {
+			return c.DeleteContext(ctx, endpoint)
+		}
+		return c.DeleteWithParamsContext(ctx, endpoint, params)
+	}
+	return Response{}, fmt.Errorf("Unsupported request method %s", method)
+}
+
 // shortcut for the most common case
 func setPostId(id uint64, params url.Values) url.Values {
 	return setParamsUint(id, params, "id")
@@ -61,3 +202,5 @@ func copyParams(src url.Values) url.Values {
 var NoNextPageError error = errors.New("No next page.")
 // Error returned for a collection's Prev() invocation if no previous page is possible
 var NoPrevPageError error = errors.New("No prev page.")
+// Error returned when a request needs a blog name that was not provided, such as fetching a PostRef without a BlogName
+var NoBlogNameError error = errors.New("No blog name provided.")
@@ -1,7 +1,9 @@
 package tumblr
 
 import (
+	"context"
 	"testing"
+	"time"
 	"net/url"
 	"net/http"
 )
@@ -57,10 +59,72 @@ func TestSetParamsUint(t *testing.T) {
 }
 
 
+func TestNewContextClient(t *testing.T) {
+	concrete := NewClient("key", "secret")
+	if c := NewContextClient(concrete); c != ContextClientInterface(concrete) {
+		t.Fatal("Clients supporting contexts should not be wrapped")
+	}
+	client := newTestClient("{}", nil)
+	wrapped := NewContextClient(client)
+	if _, ok := wrapped.(*contextClientAdapter); !ok {
+		t.Fatal("Clients without context support should be wrapped")
+	}
+	client.confirmExpectedSet = expectClientCallParams(t, "GetWithParamsContext", http.MethodGet, "/path", url.Values{"a": []string{"b"}})
+	if _, err := wrapped.GetWithParamsContext(context.Background(), "/path", url.Values{"a": []string{"b"}}); err != nil {
+		t.Fatal("Wrapped client should delegate the request")
+	}
+}
+
+func TestContextClientAdapterCancelled(t *testing.T) {
+	client := newTestClient("{}", nil)
+	client.confirmExpectedSet = func(method, path string, params url.Values) {
+		t.Fatal("Request should not be issued with a cancelled context")
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := GetDashboardContext(ctx, client, url.Values{}); err != context.Canceled {
+		t.Fatal("Cancelled context error should be returned", err)
+	}
+}
+
+func TestContextClientAdapterDeadline(t *testing.T) {
+	client := newTestClient("{}", nil)
+	release := make(chan struct{})
+	defer close(release)
+	client.confirmExpectedSet = func(method, path string, params url.Values) {
+		<-release
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10 * time.Millisecond)
+	defer cancel()
+	if _, err := GetUserInfoContext(ctx, client); err != context.DeadlineExceeded {
+		t.Fatal("Call should return once the context deadline passes", err)
+	}
+}
+
+func TestDoRequestMethods(t *testing.T) {
+	client := newTestClient("{}", nil)
+	for _, method := range []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete} {
+		for _, params := range []url.Values{nil, url.Values{"key": []string{"value"}}} {
+			expected := params
+			if expected == nil {
+				expected = url.Values{}
+			}
+			client.confirmExpectedSet = expectClientCallParams(t, "doRequest", method, "/path", expected)
+			if _, err := doRequest(context.Background(), client, method, "/path", params); err != nil {
+				t.Fatalf("%s request failed", method)
+			}
+		}
+	}
+	if _, err := doRequest(context.Background(), client, http.MethodPatch, "/path", nil); err == nil {
+		t.Fatal("Unsupported methods should generate an error")
+	}
+}
 
 type testClient struct {
 	response Response
 	err error
+	// body of the last SendBody call
+	body RequestBody
 	confirmExpectedSet func(method, path string, params url.Values)
 }
 
@@ -142,3 +206,9 @@ func (c *testClient) DeleteWithParams(endpoint string, params url.Values) (Respo
 	c.checkCallParams(http.MethodDelete, endpoint, params)
 	return c.response, c.err
 }
+
+func (c *testClient) SendBody(ctx context.Context, method, endpoint string, body RequestBody) (Response, error) {
+	c.body = body
+	c.checkCallParams(method, endpoint, url.Values{})
+	return c.response, c.err
+}
@@ -1,6 +1,8 @@
 package tumblr
 
 import (
+	"context"
+	"net/http"
 	"net/url"
 	"encoding/json"
 	"strconv"
@@ -12,28 +14,24 @@ type Dashboard struct {
 	params url.Values
 	bySince bool
 	byOffset bool
-	Posts []PostInterface `json:"posts"`
+	Posts PostList `json:"posts"`
 }
 
 // Retreive a User's dashboard
 func GetDashboard(client ClientInterface, params url.Values) (*Dashboard, error) {
+	return GetDashboardContext(context.Background(), client, params)
+}
+
+// Retrieve a User's dashboard, aborting if ctx is done
+func GetDashboardContext(ctx context.Context, client ClientInterface, params url.Values) (*Dashboard, error) {
 	if params.Get("offset") != "" && params.Get("since_id") != "" {
 		return nil, errors.New("Cannot specify both offset and since_id")
 	}
 
-	response, err := client.GetWithParams("/user/dashboard", params)
+	response, err := doRequest(ctx, client, http.MethodGet, "/user/dashboard", params)
 	if err != nil {
 		return nil, err
 	}
-	result := struct {
-		Response struct {
-				 Posts []MiniPost `json:"posts"`
-			 } `json:"response"`
-	}{}
-	if err = json.Unmarshal(response.body, &result); err != nil {
-		return nil, err
-	}
-	minis := result.Response.Posts
 	full := struct {
 		Response Dashboard `json:"response"`
 	}{
@@ -44,10 +42,10 @@ func GetDashboard(client ClientInterface, params url.Values) (*Dashboard, error)
 			bySince: params.Get("since_id") != "",
 		},
 	}
-	full.Response.Posts = makePostsFromMinis(minis, client)
 	if err = json.Unmarshal(response.body, &full); err != nil {
 		return nil, err
 	}
+	full.Response.Posts.SetClient(client)
 	return &full.Response, nil
 }
 
@@ -56,6 +54,11 @@ var MixedPaginationMethodsError error = errors.New("Cannot mix pagination betwee
 
 // Returns the next page of a user's dashboard using the current page's last Post id
 func (d *Dashboard)NextBySinceId() (*Dashboard, error) {
+	return d.NextBySinceIdContext(context.Background())
+}
+
+// Returns the next page of a user's dashboard using the current page's last Post id, aborting if ctx is done
+func (d *Dashboard)NextBySinceIdContext(ctx context.Context) (*Dashboard, error) {
 	if d.byOffset {
 		return nil, MixedPaginationMethodsError
 	}
@@ -65,11 +68,16 @@ func (d *Dashboard)NextBySinceId() (*Dashboard, error) {
 	}
 	lastId := d.Posts[size - 1].GetSelf().Id
 	params := setParamsUint(lastId, copyParams(d.params), "since_id")
-	return GetDashboard(d.client, params)
+	return GetDashboardContext(ctx, d.client, params)
 }
 
 // Returns the next page of a user's dashboard using the current page's offset
 func (d *Dashboard)NextByOffset() (*Dashboard, error) {
+	return d.NextByOffsetContext(context.Background())
+}
+
+// Returns the next page of a user's dashboard using the current page's offset, aborting if ctx is done
+func (d *Dashboard)NextByOffsetContext(ctx context.Context) (*Dashboard, error) {
 	if d.bySince {
 		return nil, MixedPaginationMethodsError
 	}
@@ -83,6 +91,6 @@ func (d *Dashboard)NextByOffset() (*Dashboard, error) {
 	}
 	offset += len(d.Posts)
 	params.Set("offset", strconv.Itoa(offset))
-	return GetDashboard(d.client, params)
+	return GetDashboardContext(ctx, d.client, params)
 }
 
@@ -0,0 +1,156 @@
+package tumblr
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// Sentinel errors which an *APIError matches via errors.Is based on its status code
+var (
+	// Matches API errors with a 400 status
+	ErrBadRequest error = errors.New("Bad request")
+	// Matches API errors with a 401 status
+	ErrUnauthorized error = errors.New("Unauthorized")
+	// Matches API errors with a 403 status
+	ErrForbidden error = errors.New("Forbidden")
+	// Matches API errors with a 404 status
+	ErrNotFound error = errors.New("Not found")
+	// Matches API errors with a 429 status
+	ErrRateLimited error = errors.New("Rate limited")
+	// Matches API errors with a 5xx status
+	ErrServerError error = errors.New("Server error")
+)
+
+// Error returned when the API responds with a non-successful status
+type APIError struct {
+	// HTTP status from the response's meta (or the HTTP response itself if the body had none)
+	StatusCode int
+	// Message from the response's meta
+	Message string
+	// Individual errors listed in the response
+	Errors []APIErrorDetail
+}
+
+// APIError substructure
+type APIErrorDetail struct {
+	Title string `json:"title"`
+	Code int `json:"code"`
+	Detail string `json:"detail"`
+}
+
+// Describes the status, message and any listed errors
+func (e *APIError) Error() string {
+	msg := e.Message
+	if msg == "" {
+		msg = http.StatusText(e.StatusCode)
+	}
+	out := fmt.Sprintf("Tumblr API error %d: %s", e.StatusCode, msg)
+	details := []string{}
+	for _, d := range e.Errors {
+		detail := d.Title
+		if d.Detail != "" {
+			if detail != "" {
+				detail += ": "
+			}
+			detail += d.Detail
+		}
+		if d.Code != 0 {
+			detail = fmt.Sprintf("%s (code %d)", detail, d.Code)
+		}
+		details = append(details, detail)
+	}
+	if len(details) > 0 {
+		out += " [" + strings.Join(details, "; ") + "]"
+	}
+	return out
+}
+
+// Allows errors.Is(err, ErrNotFound) and friends to match on the status code
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrBadRequest:
+		return e.StatusCode == http.StatusBadRequest
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrServerError:
+		return e.StatusCode >= 500
+	}
+	return false
+}
+
+// Returns an *APIError if the response's meta status indicates failure, otherwise nil.
+// Bodies which aren't JSON or carry no meta are left for the caller to decode.
+func checkResponse(response Response) error {
+	result := struct {
+		Meta struct {
+			Status int `json:"status"`
+			Msg string `json:"msg"`
+		} `json:"meta"`
+		Response json.RawMessage `json:"response"`
+		Errors json.RawMessage `json:"errors"`
+	}{}
+	if err := json.Unmarshal(response.body, &result); err != nil {
+		return nil
+	}
+	// bodies without a meta status are left for the caller; any status outside 2xx is a failure
+	if result.Meta.Status == 0 || result.Meta.Status >= 200 && result.Meta.Status < 300 {
+		return nil
+	}
+	apiErr := &APIError{
+		StatusCode: result.Meta.Status,
+		Message: result.Meta.Msg,
+		Errors: parseErrorDetails(result.Errors),
+	}
+	// older endpoints list their errors inside the response object instead
+	if len(apiErr.Errors) < 1 {
+		legacy := struct {
+			Errors json.RawMessage `json:"errors"`
+		}{}
+		if json.Unmarshal(result.Response, &legacy) == nil {
+			apiErr.Errors = parseErrorDetails(legacy.Errors)
+		}
+	}
+	return apiErr
+}
+
+// Decodes the errors list, which may be an array of objects, an array of strings, or a map of field to message
+func parseErrorDetails(raw json.RawMessage) []APIErrorDetail {
+	if len(raw) < 1 {
+		return nil
+	}
+	objects := []APIErrorDetail{}
+	if json.Unmarshal(raw, &objects) == nil {
+		return objects
+	}
+	details := []APIErrorDetail{}
+	messages := []string{}
+	if json.Unmarshal(raw, &messages) == nil {
+		for _, m := range messages {
+			details = append(details, APIErrorDetail{Detail: m})
+		}
+		return details
+	}
+	fields := map[string]interface{}{}
+	if json.Unmarshal(raw, &fields) == nil {
+		keys := make([]string, 0, len(fields))
+		for field := range fields {
+			keys = append(keys, field)
+		}
+		sort.Strings(keys)
+		for _, field := range keys {
+			details = append(details, APIErrorDetail{Title: field, Detail: fmt.Sprint(fields[field])})
+		}
+		return details
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package tumblr
+
+import (
+	"testing"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+)
+
+func TestCheckResponseSuccess(t *testing.T) {
+	for _, body := range []string{"", "{", "{}", `{"meta": {"status": 200, "msg": "OK"}}`, `{"meta": {"status": 201, "msg": "Created"}}`} {
+		if err := checkResponse(Response{body: []byte(body)}); err != nil {
+			t.Errorf("Body `%s` should not generate an API error", body)
+		}
+	}
+}
+
+func TestCheckResponseRedirectStatus(t *testing.T) {
+	err := checkResponse(Response{body: []byte(`{"meta": {"status": 301, "msg": "Moved Permanently"}}`)})
+	apiErr, ok := err.(*APIError)
+	if !ok || apiErr.StatusCode != 301 || apiErr.Message != "Moved Permanently" {
+		t.Fatal("Meta status outside 2xx should generate an *APIError", err)
+	}
+	if err = checkResponse(Response{body: []byte(`{"meta": {"status": 199, "msg": "Odd"}}`)}); err == nil {
+		t.Fatal("Meta status below 200 should generate an *APIError")
+	}
+}
+
+func TestCheckResponseErrorsArray(t *testing.T) {
+	body := `{"meta": {"status": 404, "msg": "Not Found"}, "response": [], "errors": [{"title": "Not Found", "code": 0, "detail": "This blog doesn't exist."}]}`
+	err := checkResponse(Response{body: []byte(body)})
+	apiErr, ok := err.(*APIError)
+	if !ok {
+		t.Fatal("Failed status should generate an *APIError")
+	}
+	if apiErr.StatusCode != 404 || apiErr.Message != "Not Found" {
+		t.Fatal("APIError should carry the meta status and message")
+	}
+	if len(apiErr.Errors) != 1 || apiErr.Errors[0].Detail != "This blog doesn't exist." {
+		t.Fatal("APIError should carry the listed errors")
+	}
+	if !errors.Is(err, ErrNotFound) || errors.Is(err, ErrUnauthorized) {
+		t.Fatal("APIError should match only the sentinel for its status")
+	}
+	if !strings.Contains(err.Error(), "This blog doesn't exist.") {
+		t.Fatal("Error string should include error details", err.Error())
+	}
+}
+
+func TestCheckResponseLegacyErrors(t *testing.T) {
+	body := `{"meta": {"status": 400, "msg": "Bad Request"}, "response": {"errors": ["Post cannot be empty."]}}`
+	err := checkResponse(Response{body: []byte(body)})
+	if apiErr, ok := err.(*APIError); !ok || len(apiErr.Errors) != 1 || apiErr.Errors[0].Detail != "Post cannot be empty." {
+		t.Fatal("Errors nested inside the response should be parsed", err)
+	}
+	body = `{"meta": {"status": 400, "msg": "Bad Request"}, "response": {"errors": {"state": "Invalid state", "date": "Invalid date"}}}`
+	err = checkResponse(Response{body: []byte(body)})
+	if apiErr, ok := err.(*APIError); !ok || len(apiErr.Errors) != 2 || apiErr.Errors[0].Title != "date" {
+		t.Fatal("Field errors should be parsed in a stable order", err)
+	}
+	if !errors.Is(err, ErrBadRequest) {
+		t.Fatal("400 status should match ErrBadRequest")
+	}
+}
+
+func TestAPIErrorIs(t *testing.T) {
+	testCases := map[int]error{
+		400: ErrBadRequest,
+		401: ErrUnauthorized,
+		403: ErrForbidden,
+		404: ErrNotFound,
+		429: ErrRateLimited,
+		500: ErrServerError,
+		503: ErrServerError,
+	}
+	for status, sentinel := range testCases {
+		if err := error(&APIError{StatusCode: status}); !errors.Is(err, sentinel) {
+			t.Errorf("Status %d should match %v", status, sentinel)
+		}
+	}
+	if errors.Is(&APIError{StatusCode: 418}, ErrServerError) {
+		t.Fatal("Unmapped status should not match sentinels")
+	}
+}
+
+func TestFunctionsReturnAPIError(t *testing.T) {
+	client := newTestClient(`{"meta": {"status": 401, "msg": "Unauthorized"}, "response": []}`, nil)
+	if blog, err := GetBlogInfo(client, "david"); blog != nil || !errors.Is(err, ErrUnauthorized) {
+		t.Fatal("Blog info should return an APIError instead of an empty blog")
+	}
+	if err := Follow(client, "david"); !errors.Is(err, ErrUnauthorized) {
+		t.Fatal("Follow should return an APIError")
+	}
+}
+
+func TestClientReturnsAPIErrorForHttpStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+		w.Write([]byte("<html>Bad Gateway</html>"))
+	}))
+	defer server.Close()
+	c := NewClient("consumer-key", "consumer-secret")
+	c.BaseUrl = server.URL
+	_, err := c.Get("/blog/david/info")
+	if apiErr, ok := err.(*APIError); !ok || apiErr.StatusCode != http.StatusBadGateway || !errors.Is(err, ErrServerError) {
+		t.Fatal("Client should fall back to the HTTP status when the body has no meta", err)
+	}
+}
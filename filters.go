@@ -0,0 +1,163 @@
+package tumblr
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+)
+
+// Retrieves the tags the user has filtered from their dashboard and search
+func GetFilteredTags(client ClientInterface) ([]string, error) {
+	return GetFilteredTagsContext(context.Background(), client)
+}
+
+// Retrieves the tags the user has filtered, aborting if ctx is done
+func GetFilteredTagsContext(ctx context.Context, client ClientInterface) ([]string, error) {
+	return getFilters(ctx, client, "/user/filtered_tags", "filtered_tags")
+}
+
+// Adds tags to the user's filtered tags
+func AddFilteredTags(client ClientInterface, tags ...string) error {
+	return AddFilteredTagsContext(context.Background(), client, tags...)
+}
+
+// Adds tags to the user's filtered tags, aborting if ctx is done
+func AddFilteredTagsContext(ctx context.Context, client ClientInterface, tags ...string) error {
+	_, err := doRequest(ctx, client, http.MethodPost, "/user/filtered_tags", filterParams("filtered_tags", tags...))
+	return err
+}
+
+// Removes a tag from the user's filtered tags
+func RemoveFilteredTag(client ClientInterface, tag string) error {
+	return RemoveFilteredTagContext(context.Background(), client, tag)
+}
+
+// Removes a tag from the user's filtered tags, aborting if ctx is done
+func RemoveFilteredTagContext(ctx context.Context, client ClientInterface, tag string) error {
+	_, err := doRequest(ctx, client, http.MethodDelete, "/user/filtered_tags/" + url.PathEscape(tag), nil)
+	return err
+}
+
+// Retrieves the phrases the user has filtered from their dashboard and search
+func GetFilteredContent(client ClientInterface) ([]string, error) {
+	return GetFilteredContentContext(context.Background(), client)
+}
+
+// Retrieves the phrases the user has filtered, aborting if ctx is done
+func GetFilteredContentContext(ctx context.Context, client ClientInterface) ([]string, error) {
+	return getFilters(ctx, client, "/user/filtered_content", "filtered_content")
+}
+
+// Adds phrases to the user's filtered content
+func AddFilteredContent(client ClientInterface, phrases ...string) error {
+	return AddFilteredContentContext(context.Background(), client, phrases...)
+}
+
+// Adds phrases to the user's filtered content, aborting if ctx is done
+func AddFilteredContentContext(ctx context.Context, client ClientInterface, phrases ...string) error {
+	_, err := doRequest(ctx, client, http.MethodPost, "/user/filtered_content", filterParams("filtered_content", phrases...))
+	return err
+}
+
+// Removes a phrase from the user's filtered content
+func RemoveFilteredContent(client ClientInterface, phrase string) error {
+	return RemoveFilteredContentContext(context.Background(), client, phrase)
+}
+
+// Removes a phrase from the user's filtered content, aborting if ctx is done
+func RemoveFilteredContentContext(ctx context.Context, client ClientInterface, phrase string) error {
+	_, err := doRequest(ctx, client, http.MethodDelete, "/user/filtered_content", filterParams("filtered_content", phrase))
+	return err
+}
+
+// Params listing filters under the bracketed `key[]` form, which the API decodes as an array even for a single value
+func filterParams(key string, values ...string) url.Values {
+	return url.Values{
+		key + "[]": values,
+	}
+}
+
+// helper method for retrieving a list of filters from the given path
+func getFilters(ctx context.Context, client ClientInterface, path, key string) ([]string, error) {
+	response, err := doRequest(ctx, client, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	result := struct {
+		Response map[string][]string `json:"response"`
+	}{}
+	if err = json.Unmarshal(response.body, &result); err != nil {
+		return nil, err
+	}
+	filters := result.Response[key]
+	if filters == nil {
+		filters = []string{}
+	}
+	return filters, nil
+}
+
+// Sets User's client, needed to manage the filters of a User not retrieved by GetUserInfo
+func (u *User) SetClient(c ClientInterface) {
+	u.client = c
+}
+
+// Retrieves the user's filtered tags
+func (u *User) GetFilteredTags() ([]string, error) {
+	return u.GetFilteredTagsContext(context.Background())
+}
+
+// Retrieves the user's filtered tags, aborting if ctx is done
+func (u *User) GetFilteredTagsContext(ctx context.Context) ([]string, error) {
+	return GetFilteredTagsContext(ctx, u.client)
+}
+
+// Adds tags to the user's filtered tags
+func (u *User) AddFilteredTags(tags ...string) error {
+	return u.AddFilteredTagsContext(context.Background(), tags...)
+}
+
+// Adds tags to the user's filtered tags, aborting if ctx is done
+func (u *User) AddFilteredTagsContext(ctx context.Context, tags ...string) error {
+	return AddFilteredTagsContext(ctx, u.client, tags...)
+}
+
+// Removes a tag from the user's filtered tags
+func (u *User) RemoveFilteredTag(tag string) error {
+	return u.RemoveFilteredTagContext(context.Background(), tag)
+}
+
+// Removes a tag from the user's filtered tags, aborting if ctx is done
+func (u *User) RemoveFilteredTagContext(ctx context.Context, tag string) error {
+	return RemoveFilteredTagContext(ctx, u.client, tag)
+}
+
+// Retrieves the user's filtered content
+func (u *User) GetFilteredContent() ([]string, error) {
+	return u.GetFilteredContentContext(context.Background())
+}
+
+// Retrieves the user's filtered content, aborting if ctx is done
+func (u *User) GetFilteredContentContext(ctx context.Context) ([]string, error) {
+	return GetFilteredContentContext(ctx, u.client)
+}
+
+// Adds phrases to the user's filtered content
+func (u *User) AddFilteredContent(phrases ...string) error {
+	return u.AddFilteredContentContext(context.Background(), phrases...)
+}
+
+// Adds phrases to the user's filtered content, aborting if ctx is done
+func (u *User) AddFilteredContentContext(ctx context.Context, phrases ...string) error {
+	return AddFilteredContentContext(ctx, u.client, phrases...)
+}
+
+// Removes a phrase from the user's filtered content
+func (u *User) RemoveFilteredContent(phrase string) error {
+	return u.RemoveFilteredContentContext(context.Background(), phrase)
+}
+
+// Removes a phrase from the user's filtered content, aborting if ctx is done
+func (u *User) RemoveFilteredContentContext(ctx context.Context, phrase string) error {
+	return RemoveFilteredContentContext(ctx, u.client, phrase)
+}
@@ -0,0 +1,94 @@
+package tumblr
+
+import (
+	"testing"
+	"errors"
+	"net/http"
+	"net/url"
+)
+
+func TestFilteredTags(t *testing.T) {
+	client := newTestClient(`{"response": {"filtered_tags": ["spoilers", "politics"]}}`, nil)
+	client.confirmExpectedSet = expectClientCallParams(t, "GetFilteredTags", http.MethodGet, "/user/filtered_tags", url.Values{})
+	tags, err := GetFilteredTags(client)
+	if err != nil || len(tags) != 2 || tags[1] != "politics" {
+		t.Fatal("Filtered tags not decoded correctly", err, tags)
+	}
+	client.confirmExpectedSet = expectClientCallParams(t, "AddFilteredTags", http.MethodPost, "/user/filtered_tags", url.Values{
+		"filtered_tags[]": []string{"a", "b"},
+	})
+	if err = AddFilteredTags(client, "a", "b"); err != nil {
+		t.Fatal("Failed to add filtered tags", err)
+	}
+	client.confirmExpectedSet = expectClientCallParams(t, "RemoveFilteredTag", http.MethodDelete, "/user/filtered_tags/tv%20shows", url.Values{})
+	if err = RemoveFilteredTag(client, "tv shows"); err != nil {
+		t.Fatal("Failed to remove filtered tag", err)
+	}
+}
+
+func TestFilteredContent(t *testing.T) {
+	client := newTestClient(`{"response": {}}`, nil)
+	client.confirmExpectedSet = expectClientCallParams(t, "GetFilteredContent", http.MethodGet, "/user/filtered_content", url.Values{})
+	content, err := GetFilteredContent(client)
+	if err != nil || content == nil || len(content) != 0 {
+		t.Fatal("Missing filtered content should be an empty list", err, content)
+	}
+	client.confirmExpectedSet = expectClientCallParams(t, "AddFilteredContent", http.MethodPost, "/user/filtered_content", url.Values{
+		"filtered_content[]": []string{"spoiler"},
+	})
+	if err = AddFilteredContent(client, "spoiler"); err != nil {
+		t.Fatal("Failed to add filtered content", err)
+	}
+	client.confirmExpectedSet = expectClientCallParams(t, "RemoveFilteredContent", http.MethodDelete, "/user/filtered_content", url.Values{
+		"filtered_content[]": []string{"spoiler"},
+	})
+	if err = RemoveFilteredContent(client, "spoiler"); err != nil {
+		t.Fatal("Failed to remove filtered content", err)
+	}
+	clientErr := errors.New("Client error")
+	if _, err = GetFilteredContent(newTestClient("", clientErr)); err != clientErr {
+		t.Fatal("Client error should be returned")
+	}
+}
+
+func TestUserFilters(t *testing.T) {
+	client := newTestClient(`{"response": {"user": {"name": "david"}}}`, nil)
+	user, err := GetUserInfo(client)
+	if err != nil {
+		t.Fatal("Failed to get user", err)
+	}
+	client.response = Response{body: []byte(`{"response": {"filtered_tags": ["a"]}}`)}
+	if tags, err := user.GetFilteredTags(); err != nil || len(tags) != 1 {
+		t.Fatal("User should retrieve filters with its client", err)
+	}
+	other := newTestClient("{}", nil)
+	other.confirmExpectedSet = expectClientCallParams(t, "AddFilteredTags", http.MethodPost, "/user/filtered_tags", url.Values{
+		"filtered_tags[]": []string{"a"},
+	})
+	synced := &User{}
+	synced.SetClient(other)
+	if err = synced.AddFilteredTags("a"); err != nil {
+		t.Fatal("Failed to add filtered tags", err)
+	}
+}
+
+func TestFilterParamsFormKeys(t *testing.T) {
+	client := newTestClient("{}", nil)
+	encoded := ""
+	client.confirmExpectedSet = func(method, path string, params url.Values) {
+		encoded = params.Encode()
+	}
+	cases := []struct {
+		call func() error
+		expected string
+	}{
+		{func() error { return AddFilteredTags(client, "a", "b") }, "filtered_tags%5B%5D=a&filtered_tags%5B%5D=b"},
+		{func() error { return AddFilteredContent(client, "x", "y") }, "filtered_content%5B%5D=x&filtered_content%5B%5D=y"},
+		{func() error { return RemoveFilteredContent(client, "x") }, "filtered_content%5B%5D=x"},
+	}
+	for _, c := range cases {
+		if err := c.call(); err != nil || encoded != c.expected {
+			t.Errorf("Expected form %s, got %s (%v)", c.expected, encoded, err)
+		}
+	}
+}
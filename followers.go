@@ -1,6 +1,8 @@
 package tumblr
 
 import (
+	"context"
+	"net/http"
 	"net/url"
 	"encoding/json"
 )
@@ -9,6 +11,8 @@ type FollowingList struct {
 	client ClientInterface
 	Total  uint32 `json:"total_blogs"`
 	Blogs  []Blog `json:"blogs"`
+	// blog whose following list this is, empty for the user's
+	name   string
 	offset uint
 	limit  uint
 }
@@ -33,9 +37,36 @@ type Follower struct {
 
 // Retrieves the list of blogs this user follows
 func GetFollowing(client ClientInterface, offset, limit uint) (*FollowingList, error) {
+	return GetFollowingContext(context.Background(), client, offset, limit)
+}
+
+// Retrieves the list of blogs this user follows, aborting if ctx is done
+func GetFollowingContext(ctx context.Context, client ClientInterface, offset, limit uint) (*FollowingList, error) {
+	return getFollowing(ctx, client, "", offset, limit)
+}
+
+// Retrieves the list of blogs a blog follows, which is only possible for blogs sharing who they follow
+func GetBlogFollowing(client ClientInterface, name string, offset, limit uint) (*FollowingList, error) {
+	return GetBlogFollowingContext(context.Background(), client, name, offset, limit)
+}
+
+// Retrieves the list of blogs a blog follows, aborting if ctx is done
+func GetBlogFollowingContext(ctx context.Context, client ClientInterface, name string, offset, limit uint) (*FollowingList, error) {
+	if name == "" {
+		return nil, NoBlogNameError
+	}
+	return getFollowing(ctx, client, name, offset, limit)
+}
+
+// Retrieves the list of blogs the named blog follows, or the user follows if name is empty
+func getFollowing(ctx context.Context, client ClientInterface, name string, offset, limit uint) (*FollowingList, error) {
 	params := setParamsUint(uint64(limit), url.Values{}, "limit")
 	params = setParamsUint(uint64(offset), params, "offset")
-	result, err := client.GetWithParams("/user/following", params)
+	path := "/user/following"
+	if name != "" {
+		path = blogPath("/blog/%s/following", name)
+	}
+	result, err := doRequest(ctx, client, http.MethodGet, path, params)
 	if err != nil {
 		return nil, err
 	}
@@ -44,6 +75,7 @@ func GetFollowing(client ClientInterface, offset, limit uint) (*FollowingList, e
 	}{
 		Response: FollowingList{
 			client: client,
+			name: name,
 			limit: limit,
 			offset: offset,
 		},
@@ -56,6 +88,11 @@ func GetFollowing(client ClientInterface, offset, limit uint) (*FollowingList, e
 
 // Retrieves the next page of followers
 func (f *FollowingList)Next() (*FollowingList, error) {
+	return f.NextContext(context.Background())
+}
+
+// Retrieves the next page of followers, aborting if ctx is done
+func (f *FollowingList)NextContext(ctx context.Context) (*FollowingList, error) {
 	limit := f.limit
 	if limit < 1 {
 		limit = uint(len(f.Blogs))
@@ -64,11 +101,16 @@ func (f *FollowingList)Next() (*FollowingList, error) {
 	if offset >= uint(f.Total) {
 		return nil, NoNextPageError
 	}
-	return GetFollowing(f.client, offset, limit)
+	return getFollowing(ctx, f.client, f.name, offset, limit)
 }
 
 // Retrieves the previous page of followers
 func (f *FollowingList)Prev() (*FollowingList, error) {
+	return f.PrevContext(context.Background())
+}
+
+// Retrieves the previous page of followers, aborting if ctx is done
+func (f *FollowingList)PrevContext(ctx context.Context) (*FollowingList, error) {
 	if f.offset <= 0 {
 		return nil, NoPrevPageError
 	}
@@ -80,14 +122,19 @@ func (f *FollowingList)Prev() (*FollowingList, error) {
 	if limit >= f.offset {
 		newOffset = 0
 	}
-	return GetFollowing(f.client, newOffset, limit)
+	return getFollowing(ctx, f.client, f.name, newOffset, limit)
 }
 
 // Retrieve User's followers
 func GetFollowers(client ClientInterface, name string, offset, limit uint) (*FollowerList, error) {
+	return GetFollowersContext(context.Background(), client, name, offset, limit)
+}
+
+// Retrieve User's followers, aborting if ctx is done
+func GetFollowersContext(ctx context.Context, client ClientInterface, name string, offset, limit uint) (*FollowerList, error) {
 	params := setParamsUint(uint64(offset), url.Values{}, "offset")
 	params = setParamsUint(uint64(limit), params, "limit")
-	response, err := client.GetWithParams(blogPath("/blog/%s/followers", name), params)
+	response, err := doRequest(ctx, client, http.MethodGet, blogPath("/blog/%s/followers", name), params)
 	if err != nil {
 		return nil, err
 	}
@@ -109,6 +156,11 @@ func GetFollowers(client ClientInterface, name string, offset, limit uint) (*Fol
 
 // Get next page of a user's followers
 func (f *FollowerList)Next() (*FollowerList, error){
+	return f.NextContext(context.Background())
+}
+
+// Get next page of a user's followers, aborting if ctx is done
+func (f *FollowerList)NextContext(ctx context.Context) (*FollowerList, error){
 	limit := f.limit
 	if limit < 1 {
 		limit = uint(len(f.Followers))
@@ -117,11 +169,16 @@ func (f *FollowerList)Next() (*FollowerList, error){
 	if uint32(offset) >= f.Total || len(f.Followers) < 1 {
 		return nil, NoNextPageError
 	}
-	return GetFollowers(f.client, f.name, offset, limit)
+	return GetFollowersContext(ctx, f.client, f.name, offset, limit)
 }
 
 // Get previous page of a user's followers
 func (f *FollowerList)Prev() (*FollowerList, error){
+	return f.PrevContext(context.Background())
+}
+
+// Get previous page of a user's followers, aborting if ctx is done
+func (f *FollowerList)PrevContext(ctx context.Context) (*FollowerList, error){
 	if f.offset <= 0 {
 		return nil, NoPrevPageError
 	}
@@ -133,12 +190,41 @@ func (f *FollowerList)Prev() (*FollowerList, error){
 	if limit >= f.offset {
 		offset = 0
 	}
-	return GetFollowers(f.client, f.name, offset, limit)
+	return GetFollowersContext(ctx, f.client, f.name, offset, limit)
+}
+
+// Checks whether the blog is followed by the blog named by query
+func FollowedBy(client ClientInterface, name, query string) (bool, error) {
+	return FollowedByContext(context.Background(), client, name, query)
+}
+
+// Checks whether the blog is followed by the blog named by query, aborting if ctx is done
+func FollowedByContext(ctx context.Context, client ClientInterface, name, query string) (bool, error) {
+	response, err := doRequest(ctx, client, http.MethodGet, blogPath("/blog/%s/followed_by", name), url.Values{
+		"query": []string{query},
+	})
+	if err != nil {
+		return false, err
+	}
+	result := struct {
+		Response struct {
+			FollowedBy bool `json:"followed_by"`
+		} `json:"response"`
+	}{}
+	if err = json.Unmarshal(response.body, &result); err != nil {
+		return false, err
+	}
+	return result.Response.FollowedBy, nil
 }
 
 // Follow a blog
 func Follow(client ClientInterface, blogName string) error {
-	_, err := client.PostWithParams("/user/follow", url.Values{
+	return FollowContext(context.Background(), client, blogName)
+}
+
+// Follow a blog, aborting if ctx is done
+func FollowContext(ctx context.Context, client ClientInterface, blogName string) error {
+	_, err := doRequest(ctx, client, http.MethodPost, "/user/follow", url.Values{
 		"url": []string{normalizeBlogName(blogName)},
 	})
 	return err
@@ -146,7 +232,12 @@ func Follow(client ClientInterface, blogName string) error {
 
 // Unfollow a blog
 func Unfollow(client ClientInterface, blogName string) error {
-	_, err := client.PostWithParams("/user/unfollow", url.Values{
+	return UnfollowContext(context.Background(), client, blogName)
+}
+
+// Unfollow a blog, aborting if ctx is done
+func UnfollowContext(ctx context.Context, client ClientInterface, blogName string) error {
+	_, err := doRequest(ctx, client, http.MethodPost, "/user/unfollow", url.Values{
 		"url": []string{normalizeBlogName(blogName)},
 	})
 	return err
@@ -320,6 +320,55 @@ func TestUnfollow(t *testing.T) {
 	}
 }
 
+func TestGetBlogFollowing(t *testing.T) {
+	client := newTestClient(getFollowerString(5, Blog{}, Blog{}, Blog{}), nil)
+	path := blogPath("/blog/%s/following", "david")
+	client.confirmExpectedSet = expectClientCallParams(t, "GetBlogFollowing", http.MethodGet, path, url.Values{
+		"limit": []string{"0"},
+		"offset": []string{"0"},
+	})
+	result, err := NewBlogRef(client, "david").GetFollowing()
+	if err != nil || len(result.Blogs) != 3 {
+		t.Fatal("Failed to get blog following", err)
+	}
+	client.confirmExpectedSet = expectClientCallParams(t, "Following.Next", http.MethodGet, path, url.Values{
+		"limit": []string{"3"},
+		"offset": []string{"3"},
+	})
+	next, err := result.Next()
+	if err != nil {
+		t.Fatal("Failed to get next page", err)
+	}
+	client.confirmExpectedSet = expectClientCallParams(t, "Following.Prev", http.MethodGet, path, url.Values{
+		"limit": []string{"3"},
+		"offset": []string{"0"},
+	})
+	if _, err = next.Prev(); err != nil {
+		t.Fatal("Failed to get previous page", err)
+	}
+	if _, err = GetBlogFollowing(client, "", 0, 0); err == nil {
+		t.Fatal("Missing blog name should generate an error")
+	}
+}
+
+func TestFollowedBy(t *testing.T) {
+	client := newTestClient(`{"response": {"followed_by": true}}`, nil)
+	client.confirmExpectedSet = expectClientCallParams(t, "FollowedBy", http.MethodGet, blogPath("/blog/%s/followed_by", "david"), url.Values{
+		"query": []string{"staff"},
+	})
+	if followed, err := NewBlogRef(client, "david").FollowedBy("staff"); err != nil || !followed {
+		t.Fatal("Followed by status not decoded", err)
+	}
+	client.response = Response{body: []byte(`{"response": {"followed_by": false}}`)}
+	if followed, err := FollowedBy(client, "david", "staff"); err != nil || followed {
+		t.Fatal("Followed by status not decoded", err)
+	}
+	client.response = Response{body: []byte("{")}
+	if _, err := FollowedBy(client, "david", "staff"); err == nil {
+		t.Fatal("Invalid JSON should generate an error")
+	}
+}
+
 func getFollowerString(total uint, blogs...Blog) string {
 	return jsonStringify(map[string]interface{}{
 		"response": map[string]interface{}{
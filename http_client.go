@@ -0,0 +1,362 @@
+package tumblr
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Location of the Tumblr API which endpoints are appended to unless a client specifies otherwise
+const DefaultBaseUrl = "https://api.tumblr.com/v2"
+
+// Concrete ClientInterface which signs requests with OAuth 1.0a on behalf of a user.
+// If no user token is set, requests are instead authenticated with the consumer key as the `api_key` param,
+// which is sufficient for the API's public endpoints (blog info, posts, tagged search, etc).
+type Client struct {
+	consumerKey string
+	consumerSecret string
+	token string
+	tokenSecret string
+	// Base URL of the API, DefaultBaseUrl if empty
+	BaseUrl string
+	// HTTP client used to issue requests, a non-redirecting client is used if nil
+	HttpClient *http.Client
+	// time and nonce sources, overridden in tests to produce deterministic signatures
+	now func() time.Time
+	nonce func() string
+}
+
+// Creates a client which authenticates only with the consumer key (API key mode)
+func NewClient(consumerKey, consumerSecret string) *Client {
+	return &Client{
+		consumerKey: consumerKey,
+		consumerSecret: consumerSecret,
+		BaseUrl: DefaultBaseUrl,
+	}
+}
+
+// Creates a client which signs requests on behalf of the user the token/secret pair belongs to
+func NewClientWithToken(consumerKey, consumerSecret, token, tokenSecret string) *Client {
+	c := NewClient(consumerKey, consumerSecret)
+	c.SetToken(token, tokenSecret)
+	return c
+}
+
+// Sets the user token/secret pair; an empty token reverts the client to API key mode
+func (c *Client) SetToken(token, tokenSecret string) {
+	c.token = token
+	c.tokenSecret = tokenSecret
+}
+
+// Issue GET request to Tumblr API
+func (c *Client) Get(endpoint string) (Response, error) {
+	return c.GetWithParamsContext(context.Background(), endpoint, url.Values{})
+}
+
+// Issue GET request to Tumblr API with param values
+func (c *Client) GetWithParams(endpoint string, params url.Values) (Response, error) {
+	return c.GetWithParamsContext(context.Background(), endpoint, params)
+}
+
+// Issue GET request to Tumblr API, aborted if ctx is done
+func (c *Client) GetContext(ctx context.Context, endpoint string) (Response, error) {
+	return c.GetWithParamsContext(ctx, endpoint, url.Values{})
+}
+
+// Issue GET request to Tumblr API with param values, aborted if ctx is done
+func (c *Client) GetWithParamsContext(ctx context.Context, endpoint string, params url.Values) (Response, error) {
+	return c.request(ctx, http.MethodGet, endpoint, params)
+}
+
+// Issue POST request to Tumblr API
+func (c *Client) Post(endpoint string) (Response, error) {
+	return c.PostWithParamsContext(context.Background(), endpoint, url.Values{})
+}
+
+// Issue POST request to Tumblr API with param values
+func (c *Client) PostWithParams(endpoint string, params url.Values) (Response, error) {
+	return c.PostWithParamsContext(context.Background(), endpoint, params)
+}
+
+// Issue POST request to Tumblr API, aborted if ctx is done
+func (c *Client) PostContext(ctx context.Context, endpoint string) (Response, error) {
+	return c.PostWithParamsContext(ctx, endpoint, url.Values{})
+}
+
+// Issue POST request to Tumblr API with param values, aborted if ctx is done
+func (c *Client) PostWithParamsContext(ctx context.Context, endpoint string, params url.Values) (Response, error) {
+	return c.request(ctx, http.MethodPost, endpoint, params)
+}
+
+// Issue PUT request to Tumblr API
+func (c *Client) Put(endpoint string) (Response, error) {
+	return c.PutWithParamsContext(context.Background(), endpoint, url.Values{})
+}
+
+// Issue PUT request to Tumblr API with param values
+func (c *Client) PutWithParams(endpoint string, params url.Values) (Response, error) {
+	return c.PutWithParamsContext(context.Background(), endpoint, params)
+}
+
+// Issue PUT request to Tumblr API, aborted if ctx is done
+func (c *Client) PutContext(ctx context.Context, endpoint string) (Response, error) {
+	return c.PutWithParamsContext(ctx, endpoint, url.Values{})
+}
+
+// Issue PUT request to Tumblr API with param values, aborted if ctx is done
+func (c *Client) PutWithParamsContext(ctx context.Context, endpoint string, params url.Values) (Response, error) {
+	return c.request(ctx, http.MethodPut, endpoint, params)
+}
+
+// Issue DELETE request to Tumblr API
+func (c *Client) Delete(endpoint string) (Response, error) {
+	return c.DeleteWithParamsContext(context.Background(), endpoint, url.Values{})
+}
+
+// Issue DELETE request to Tumblr API with param values
+func (c *Client) DeleteWithParams(endpoint string, params url.Values) (Response, error) {
+	return c.DeleteWithParamsContext(context.Background(), endpoint, params)
+}
+
+// Issue DELETE request to Tumblr API, aborted if ctx is done
+func (c *Client) DeleteContext(ctx context.Context, endpoint string) (Response, error) {
+	return c.DeleteWithParamsContext(ctx, endpoint, url.Values{})
+}
+
+// Issue DELETE request to Tumblr API with param values, aborted if ctx is done
+func (c *Client) DeleteWithParamsContext(ctx context.Context, endpoint string, params url.Values) (Response, error) {
+	return c.request(ctx, http.MethodDelete, endpoint, params)
+}
+
+// Issues the request and wraps the result in a Response
+func (c *Client) request(ctx context.Context, method, endpoint string, params url.Values) (Response, error) {
+	req, err := c.newRequest(ctx, method, endpoint, params)
+	if err != nil {
+		return Response{}, err
+	}
+	return c.do(req)
+}
+
+// Sends a prepared request and reads the entire body into a Response
+func (c *Client) do(req *http.Request) (Response, error) {
+	resp, err := c.httpClient().Do(req)
+	if err != nil {
+		return Response{}, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return Response{}, err
+	}
+	response := NewResponse(body, resp.Header)
+	// not every endpoint responds with JSON (eg avatar redirects), so a failure here is left for callers to handle
+	response.PopulateFromBody()
+	if resp.StatusCode >= 400 {
+		if err = checkResponse(*response); err == nil {
+			// no usable meta in the body (eg a proxy's error page), so fall back to the HTTP status
+			err = &APIError{StatusCode: resp.StatusCode, Message: http.StatusText(resp.StatusCode)}
+		}
+		return *response, err
+	}
+	return *response, nil
+}
+
+// Issues a request whose body is neither empty nor form encoded, such as JSON or multipart uploads.
+// Only the query string and OAuth params are signed, as OAuth 1.0a excludes such bodies from the signature.
+func (c *Client) SendBody(ctx context.Context, method, endpoint string, body RequestBody) (Response, error) {
+	u, err := c.endpointUrl(endpoint, c.authParams(nil))
+	if err != nil {
+		return Response{}, err
+	}
+	reader, err := body.Open()
+	if err != nil {
+		return Response{}, err
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), reader)
+	if err != nil {
+		reader.Close()
+		return Response{}, err
+	}
+	req.Header.Set("Content-Type", body.ContentType())
+	if c.token != "" {
+		req.Header.Set("Authorization", c.authorizationHeader(method, u, nil))
+	}
+	return c.do(req)
+}
+
+// Builds the HTTP request, placing params in the query string for GET/DELETE and in a form body otherwise
+func (c *Client) newRequest(ctx context.Context, method, endpoint string, params url.Values) (*http.Request, error) {
+	params = c.authParams(params)
+	var query url.Values
+	var form url.Values
+	var body io.Reader
+	if method == http.MethodGet || method == http.MethodDelete {
+		query = params
+	} else {
+		form = params
+		body = strings.NewReader(params.Encode())
+	}
+	u, err := c.endpointUrl(endpoint, query)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+	if form != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", c.authorizationHeader(method, u, form))
+	}
+	return req, nil
+}
+
+// Copies params, adding the consumer key as `api_key` if there is no user token to sign requests with
+func (c *Client) authParams(params url.Values) url.Values {
+	params = copyParams(params)
+	if c.token == "" {
+		params.Set("api_key", c.consumerKey)
+	}
+	return params
+}
+
+// Resolves the endpoint against the base URL with the given params added to its query string
+func (c *Client) endpointUrl(endpoint string, params url.Values) (*url.URL, error) {
+	u, err := url.Parse(c.baseUrl() + endpoint)
+	if err != nil {
+		return nil, err
+	}
+	query := u.Query()
+	for k, v := range params {
+		query[k] = v
+	}
+	u.RawQuery = query.Encode()
+	return u, nil
+}
+
+// Trims any trailing slash so endpoints (which begin with a slash) may be appended
+func (c *Client) baseUrl() string {
+	if c.BaseUrl == "" {
+		return DefaultBaseUrl
+	}
+	return strings.TrimRight(c.BaseUrl, "/")
+}
+
+// Redirects are not followed by default so the avatar endpoint's Location header is visible to GetAvatar
+func (c *Client) httpClient() *http.Client {
+	if c.HttpClient != nil {
+		return c.HttpClient
+	}
+	return &http.Client{
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// Generates the OAuth 1.0a Authorization header value for a request, signing the query string and form params
+func (c *Client) authorizationHeader(method string, u *url.URL, form url.Values) string {
+	now := time.Now
+	if c.now != nil {
+		now = c.now
+	}
+	nonce := newNonce
+	if c.nonce != nil {
+		nonce = c.nonce
+	}
+	oauthParams := url.Values{}
+	oauthParams.Set("oauth_consumer_key", c.consumerKey)
+	oauthParams.Set("oauth_nonce", nonce())
+	oauthParams.Set("oauth_signature_method", "HMAC-SHA1")
+	oauthParams.Set("oauth_timestamp", strconv.FormatInt(now().Unix(), 10))
+	oauthParams.Set("oauth_token", c.token)
+	oauthParams.Set("oauth_version", "1.0")
+
+	signed := copyParams(oauthParams)
+	for _, values := range []url.Values{u.Query(), form} {
+		for k, v := range values {
+			signed[k] = append(signed[k], v...)
+		}
+	}
+	oauthParams.Set("oauth_signature", c.signature(method, u, signed))
+
+	keys := make([]string, 0, len(oauthParams))
+	for k := range oauthParams {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		parts = append(parts, fmt.Sprintf("%s=\"%s\"", oauthEscape(k), oauthEscape(oauthParams.Get(k))))
+	}
+	return "OAuth " + strings.Join(parts, ", ")
+}
+
+// Computes the HMAC-SHA1 signature of the request per RFC 5849 section 3.4
+func (c *Client) signature(method string, u *url.URL, params url.Values) string {
+	type pair struct {
+		key, value string
+	}
+	pairs := []pair{}
+	for k, values := range params {
+		for _, v := range values {
+			pairs = append(pairs, pair{oauthEscape(k), oauthEscape(v)})
+		}
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i].key != pairs[j].key {
+			return pairs[i].key < pairs[j].key
+		}
+		return pairs[i].value < pairs[j].value
+	})
+	encoded := make([]string, 0, len(pairs))
+	for _, p := range pairs {
+		encoded = append(encoded, p.key+"="+p.value)
+	}
+	baseUrl := fmt.Sprintf("%s://%s%s", strings.ToLower(u.Scheme), strings.ToLower(u.Host), u.EscapedPath())
+	base := strings.Join([]string{
+		strings.ToUpper(method),
+		oauthEscape(baseUrl),
+		oauthEscape(strings.Join(encoded, "&")),
+	}, "&")
+	mac := hmac.New(sha1.New, []byte(oauthEscape(c.consumerSecret)+"&"+oauthEscape(c.tokenSecret)))
+	mac.Write([]byte(base))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// Percent-encodes a value as required by OAuth (RFC 3986 unreserved characters are left as-is)
+func oauthEscape(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+		if ('A' <= ch && ch <= 'Z') || ('a' <= ch && ch <= 'z') || ('0' <= ch && ch <= '9') ||
+			ch == '-' || ch == '.' || ch == '_' || ch == '~' {
+			b.WriteByte(ch)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", ch)
+		}
+	}
+	return b.String()
+}
+
+// Generates a random nonce for OAuth signatures
+func newNonce() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return strconv.FormatInt(time.Now().UnixNano(), 10)
+	}
+	return hex.EncodeToString(b)
+}
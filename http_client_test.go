@@ -0,0 +1,169 @@
+package tumblr
+
+import (
+	"context"
+	"testing"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"time"
+)
+
+func TestOauthEscape(t *testing.T) {
+	testCases := map[string]string{
+		"abcXYZ019-._~": "abcXYZ019-._~",
+		"Ladies + Gentlemen": "Ladies%20%2B%20Gentlemen",
+		"a/b?c=d&e": "a%2Fb%3Fc%3Dd%26e",
+		"☃": "%E2%98%83",
+	}
+	for input, expected := range testCases {
+		if actual := oauthEscape(input); actual != expected {
+			t.Errorf("Expected `%s` to escape to `%s`, got `%s`", input, expected, actual)
+		}
+	}
+}
+
+// Uses the worked example from Twitter's "Creating a signature" documentation as a known-good vector
+func TestClientAuthorizationHeader(t *testing.T) {
+	c := NewClientWithToken(
+		"xvz1evFS4wEEPTGEFPHBog",
+		"kAcSOqF21Fu85e7zjz7ZN2U4ZRhfV3WpwPAoE3Z7kBw",
+		"370773112-GmHxMAgYyLbNEtIKZeRNFsMKPR9EyMZeS9weJAEb",
+		"LswwdoUaIvS8ltyTt5jkRh4J50vUPVVHtR2YPi5kE",
+	)
+	c.BaseUrl = "https://api.twitter.com/1.1"
+	c.now = func() time.Time {
+		return time.Unix(1318622958, 0)
+	}
+	c.nonce = func() string {
+		return "kYjzVBB8Y0ZFabxSWbWovY3uYSQ2pTgmZeNu2VS4cg"
+	}
+	params := url.Values{}
+	params.Set("status", "Hello Ladies + Gentlemen, a signed OAuth request!")
+	req, err := c.newRequest(context.Background(), http.MethodPost, "/statuses/update.json?include_entities=true", params)
+	if err != nil {
+		t.Fatal("Failed to build request", err)
+	}
+	header := req.Header.Get("Authorization")
+	if !strings.HasPrefix(header, "OAuth ") {
+		t.Fatal("Authorization header should use the OAuth scheme", header)
+	}
+	if !strings.Contains(header, `oauth_signature="hCtSmYh%2BiHYCEqBWrE7C7hYmtUk%3D"`) {
+		t.Fatal("Incorrect OAuth signature generated", header)
+	}
+	if req.URL.Query().Get("api_key") != "" {
+		t.Fatal("Signed requests should not send an api_key")
+	}
+}
+
+func TestClientApiKeyMode(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if r.URL.Path != "/blog/david/info" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("api_key") != "consumer-key" {
+			t.Error("API key mode should send the consumer key as api_key")
+		}
+		if r.Header.Get("Authorization") != "" {
+			t.Error("API key mode should not sign requests")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"meta": {"status": 200, "msg": "OK"}, "response": {"blog": {"name": "david"}}}`))
+	}))
+	defer server.Close()
+	c := NewClient("consumer-key", "consumer-secret")
+	c.BaseUrl = server.URL
+	blog, err := GetBlogInfo(c, "david")
+	if err != nil {
+		t.Fatal("Failed to get blog info", err)
+	}
+	if blog.Name != "david" {
+		t.Fatal("Blog info was not decoded from server response")
+	}
+}
+
+func TestClientSignedPost(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST request, got %s", r.Method)
+		}
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "OAuth ") {
+			t.Error("Requests with a user token should be signed")
+		}
+		if r.FormValue("reblog_key") != "key" || r.FormValue("id") != "1986" {
+			t.Error("Params should be sent in the form body", r.Form)
+		}
+		w.Header().Set("X-Test", "value")
+		w.Write([]byte(`{"meta": {"status": 200, "msg": "OK"}, "response": []}`))
+	}))
+	defer server.Close()
+	c := NewClientWithToken("consumer-key", "consumer-secret", "token", "token-secret")
+	c.BaseUrl = server.URL + "/"
+	params := setPostId(1986, url.Values{})
+	params.Set("reblog_key", "key")
+	response, err := c.PostWithParams("/user/like", params)
+	if err != nil {
+		t.Fatal("Request failed", err)
+	}
+	if response.Headers.Get("X-Test") != "value" {
+		t.Fatal("Response headers should be captured")
+	}
+	if len(response.GetBody()) < 1 || response.Meta["msg"] != "OK" {
+		t.Fatal("Response body should be captured and populated")
+	}
+	if params.Get("api_key") != "" {
+		t.Fatal("Client should not modify the caller's params")
+	}
+}
+
+func TestClientSendBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/blog/david.tumblr.com/posts/1986" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if r.Header.Get("Content-Type") != "application/json" {
+			t.Error("Body content type should be sent", r.Header.Get("Content-Type"))
+		}
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "OAuth ") {
+			t.Error("Requests with a user token should be signed")
+		}
+		if body, _ := io.ReadAll(r.Body); string(body) != `{"a":1}` {
+			t.Error("Body should be sent unmodified", string(body))
+		}
+		w.Write([]byte(`{"meta": {"status": 200, "msg": "OK"}, "response": {"id": "1986"}}`))
+	}))
+	defer server.Close()
+	c := NewClientWithToken("consumer-key", "consumer-secret", "token", "token-secret")
+	c.BaseUrl = server.URL
+	body, _ := NewJSONBody(map[string]int{"a": 1})
+	response, err := c.SendBody(context.Background(), http.MethodPut, "/blog/david.tumblr.com/posts/1986", body)
+	if err != nil || response.Meta["msg"] != "OK" {
+		t.Fatal("Request failed", err)
+	}
+}
+
+func TestClientDoesNotFollowRedirects(t *testing.T) {
+	uri := "http://placekitten.com"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, uri, http.StatusMovedPermanently)
+	}))
+	defer server.Close()
+	c := NewClient("consumer-key", "consumer-secret")
+	c.BaseUrl = server.URL
+	if location, err := GetAvatar(c, "david"); err != nil || location != uri {
+		t.Fatal("Avatar location should be read from the redirect", location, err)
+	}
+}
+
+func TestClientTransportError(t *testing.T) {
+	c := NewClient("consumer-key", "consumer-secret")
+	c.BaseUrl = "http://127.0.0.1:0"
+	if _, err := c.Get("/user/info"); err == nil {
+		t.Fatal("Transport errors should be returned")
+	}
+}
@@ -0,0 +1,142 @@
+package tumblr
+
+import (
+	"context"
+	"net/url"
+	"strings"
+)
+
+// Retrieves a page of a blog's inbox: asks awaiting an answer, which are decoded as *AnswerPost, and submissions.
+// Asks are answered with AnswerAsk and deleted with DeleteAsk. The API has no endpoint for sending asks or
+// fan mail, so only a blog's received asks can be managed.
+func GetInbox(client ClientInterface, name string, params url.Values) (*Posts, error) {
+	return GetInboxContext(context.Background(), client, name, params)
+}
+
+// Retrieves a page of a blog's inbox, aborting if ctx is done
+func GetInboxContext(ctx context.Context, client ClientInterface, name string, params url.Values) (*Posts, error) {
+	return GetSubmissionsContext(ctx, client, name, params)
+}
+
+// Retrieves a page of the inbox of the given blog reference
+func (b *BlogRef) GetInbox(params url.Values) (*Posts, error) {
+	return b.GetInboxContext(context.Background(), params)
+}
+
+// Retrieves a page of the inbox of the given blog reference, aborting if ctx is done
+func (b *BlogRef) GetInboxContext(ctx context.Context, params url.Values) (*Posts, error) {
+	return GetInboxContext(ctx, b.client, b.Name, params)
+}
+
+// Returns the asks among the page's posts
+func (p *Posts) Asks() ([]*AnswerPost, error) {
+	all, err := p.All()
+	if err != nil {
+		return nil, err
+	}
+	asks := []*AnswerPost{}
+	for _, post := range all {
+		if ask, ok := post.(*AnswerPost); ok {
+			asks = append(asks, ask)
+		}
+	}
+	return asks, nil
+}
+
+// Returns the page's posts which are not asks
+func (p *Posts) Submissions() ([]PostInterface, error) {
+	all, err := p.All()
+	if err != nil {
+		return nil, err
+	}
+	submissions := []PostInterface{}
+	for _, post := range all {
+		if _, ok := post.(*AnswerPost); !ok {
+			submissions = append(submissions, post)
+		}
+	}
+	return submissions, nil
+}
+
+// Whether the ask was sent anonymously
+func (p *AnswerPost) IsAnonymous() bool {
+	return p.AskingUrl == ""
+}
+
+// Answers an ask, publishing it unless params specify another state
+func AnswerAsk(client ClientInterface, name string, postId uint64, answer string, params url.Values) error {
+	return AnswerAskContext(context.Background(), client, name, postId, answer, params)
+}
+
+// Answers an ask, aborting if ctx is done
+func AnswerAskContext(ctx context.Context, client ClientInterface, name string, postId uint64, answer string, params url.Values) error {
+	params = copyParams(params)
+	params.Set("answer", answer)
+	if params.Get("state") == "" {
+		params.Set("state", string(StatePublished))
+	}
+	return EditPostContext(ctx, client, name, postId, params)
+}
+
+// Convenience method to allow calling ask.PublishAnswer(answer, params)
+func (p *AnswerPost) PublishAnswer(answer string, params url.Values) error {
+	return p.PublishAnswerContext(context.Background(), answer, params)
+}
+
+// Convenience method to allow calling ask.PublishAnswerContext(ctx, answer, params)
+func (p *AnswerPost) PublishAnswerContext(ctx context.Context, answer string, params url.Values) error {
+	if err := AnswerAskContext(ctx, p.client, p.BlogName, p.Id, answer, params); err != nil {
+		return err
+	}
+	p.Answer = answer
+	return nil
+}
+
+// Deletes an ask without answering it
+func DeleteAsk(client ClientInterface, name string, postId uint64) error {
+	return DeleteAskContext(context.Background(), client, name, postId)
+}
+
+// Deletes an ask without answering it, aborting if ctx is done
+func DeleteAskContext(ctx context.Context, client ClientInterface, name string, postId uint64) error {
+	return DeletePostContext(ctx, client, name, postId)
+}
+
+// Convenience method to allow calling ask.DeleteAsk()
+func (p *AnswerPost) DeleteAsk() error {
+	return p.DeleteAskContext(context.Background())
+}
+
+// Convenience method to allow calling ask.DeleteAskContext(ctx)
+func (p *AnswerPost) DeleteAskContext(ctx context.Context) error {
+	return DeleteAskContext(ctx, p.client, p.BlogName, p.Id)
+}
+
+// Accepts a submission, moving it into the given state (published if empty) with the given tags
+func (p *PostRef) AcceptSubmission(state PostState, tags []string) error {
+	return p.AcceptSubmissionContext(context.Background(), state, tags)
+}
+
+// Accepts a submission, aborting if ctx is done
+func (p *PostRef) AcceptSubmissionContext(ctx context.Context, state PostState, tags []string) error {
+	if state == "" {
+		state = StatePublished
+	}
+	params := url.Values{
+		"state": []string{string(state)},
+	}
+	if len(tags) > 0 {
+		params.Set("tags", strings.Join(tags, ","))
+	}
+	return EditPostContext(ctx, p.client, p.BlogName, p.Id, params)
+}
+
+// Rejects a submission, deleting it
+func (p *PostRef) RejectSubmission() error {
+	return p.RejectSubmissionContext(context.Background())
+}
+
+// Rejects a submission, aborting if ctx is done
+func (p *PostRef) RejectSubmissionContext(ctx context.Context) error {
+	return p.DeleteContext(ctx)
+}
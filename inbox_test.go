@@ -0,0 +1,110 @@
+package tumblr
+
+import (
+	"testing"
+	"net/http"
+	"net/url"
+)
+
+const inboxJson = `{"response": {"posts": [
+	{"id": 1, "type": "answer", "blog_name": "david", "asking_name": "Anonymous", "question": "Hi?"},
+	{"id": 2, "type": "answer", "blog_name": "david", "asking_name": "staff", "asking_url": "https://staff.tumblr.com/", "question": "Hello?"},
+	{"id": 3, "type": "text", "blog_name": "david", "title": "Submitted"}
+], "total_posts": 3}}`
+
+func TestGetInbox(t *testing.T) {
+	client := newTestClient(inboxJson, nil)
+	client.confirmExpectedSet = expectClientCallParams(t, "GetInbox", http.MethodGet, blogPath("/blog/%s/posts/submission", "david"), url.Values{})
+	inbox, err := NewBlogRef(client, "david").GetInbox(url.Values{})
+	if err != nil {
+		t.Fatal("Failed to get inbox", err)
+	}
+	asks, err := inbox.Asks()
+	if err != nil || len(asks) != 2 || asks[0].Question != "Hi?" {
+		t.Fatal("Asks not decoded correctly", err)
+	}
+	if !asks[0].IsAnonymous() || asks[1].IsAnonymous() {
+		t.Fatal("Anonymous asks not detected")
+	}
+	if submissions, err := inbox.Submissions(); err != nil || len(submissions) != 1 || submissions[0].GetSelf().Id != 3 {
+		t.Fatal("Submissions not separated from asks", err)
+	}
+}
+
+func TestAnswerAsk(t *testing.T) {
+	client := newTestClient(inboxJson, nil)
+	inbox, _ := GetInbox(client, "david", url.Values{})
+	asks, _ := inbox.Asks()
+	client.confirmExpectedSet = expectClientCallParams(t, "PublishAnswer", http.MethodPost, blogPath("/blog/%s/post/edit", "david"), url.Values{
+		"id": []string{"1"},
+		"answer": []string{"Hello!"},
+		"state": []string{"published"},
+		"tags": []string{"asks"},
+	})
+	if err := asks[0].PublishAnswer("Hello!", url.Values{"tags": []string{"asks"}}); err != nil {
+		t.Fatal("Failed to answer ask", err)
+	}
+	if asks[0].Answer != "Hello!" {
+		t.Fatal("Answer should be set on the ask")
+	}
+	client.confirmExpectedSet = expectClientCallParams(t, "AnswerAsk", http.MethodPost, blogPath("/blog/%s/post/edit", "david"), url.Values{
+		"id": []string{"2"},
+		"answer": []string{"Later"},
+		"state": []string{"queue"},
+	})
+	if err := AnswerAsk(client, "david", 2, "Later", url.Values{"state": []string{"queue"}}); err != nil {
+		t.Fatal("Failed to answer ask", err)
+	}
+	client.confirmExpectedSet = expectClientCallParams(t, "Delete", http.MethodPost, blogPath("/blog/%s/post/delete", "david"), url.Values{
+		"id": []string{"2"},
+	})
+	if err := asks[1].Delete(); err != nil {
+		t.Fatal("Failed to delete ask", err)
+	}
+}
+
+func TestDeleteAsk(t *testing.T) {
+	client := newTestClient(inboxJson, nil)
+	inbox, _ := GetInbox(client, "david", url.Values{})
+	asks, _ := inbox.Asks()
+	path := blogPath("/blog/%s/post/delete", "david")
+	client.confirmExpectedSet = expectClientCallParams(t, "DeleteAsk", http.MethodPost, path, url.Values{
+		"id": []string{"1"},
+	})
+	if err := asks[0].DeleteAsk(); err != nil {
+		t.Fatal("Failed to delete ask", err)
+	}
+	client.confirmExpectedSet = expectClientCallParams(t, "DeleteAsk", http.MethodPost, path, url.Values{
+		"id": []string{"2"},
+	})
+	if err := DeleteAsk(client, "david", 2); err != nil {
+		t.Fatal("Failed to delete ask", err)
+	}
+}
+
+func TestPostRef_AcceptSubmission(t *testing.T) {
+	client := newTestClient("{}", nil)
+	ref := &PostRef{client: client, MiniPost: MiniPost{Id: 3, BlogName: "david"}}
+	path := blogPath("/blog/%s/post/edit", "david")
+	client.confirmExpectedSet = expectClientCallParams(t, "AcceptSubmission", http.MethodPost, path, url.Values{
+		"id": []string{"3"},
+		"state": []string{"queue"},
+		"tags": []string{"fan art,cats"},
+	})
+	if err := ref.AcceptSubmission(StateQueue, []string{"fan art", "cats"}); err != nil {
+		t.Fatal("Failed to accept submission", err)
+	}
+	client.confirmExpectedSet = expectClientCallParams(t, "AcceptSubmission", http.MethodPost, path, url.Values{
+		"id": []string{"3"},
+		"state": []string{"published"},
+	})
+	if err := ref.AcceptSubmission("", nil); err != nil {
+		t.Fatal("Failed to accept submission", err)
+	}
+	client.confirmExpectedSet = expectClientCallParams(t, "RejectSubmission", http.MethodPost, blogPath("/blog/%s/post/delete", "david"), url.Values{
+		"id": []string{"3"},
+	})
+	if err := ref.RejectSubmission(); err != nil {
+		t.Fatal("Failed to reject submission", err)
+	}
+}
@@ -0,0 +1,378 @@
+package tumblr
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Options controlling how far an Iterator walks
+type IteratorOptions struct {
+	// Stop after this many items, 0 for no limit
+	MaxItems int
+	// Stop upon reaching an item older than this time, zero value for no limit; ignored by
+	// IterateFollowers and IterateFollowing, whose items carry no follow time
+	StopBefore time.Time
+	// Number of items requested per page, 0 for the API's default
+	PageSize int
+}
+
+// Cursor which walks every page of a paginated collection, fetching pages as they are needed:
+//
+//	it := IteratePosts(client, "staff", url.Values{}, IteratorOptions{MaxItems: 100})
+//	for it.Next() {
+//		post := it.Item()
+//	}
+//	if err := it.Err(); err != nil {
+//		// handle error
+//	}
+type Iterator[T any] struct {
+	ctx context.Context
+	fetch pageFetcher[T]
+	timestamp func(T) int64
+	options IteratorOptions
+	page []T
+	index int
+	item T
+	count int
+	err error
+	done bool
+}
+
+// Retrieves a page of items along with the fetcher for the page following it
+type pageFetcher[T any] func(ctx context.Context) ([]T, pageFetcher[T], error)
+
+// Creates an iterator starting from the given fetcher; timestamp may be nil if items have no notion of time
+func newIterator[T any](ctx context.Context, fetch pageFetcher[T], timestamp func(T) int64, options IteratorOptions) *Iterator[T] {
+	return &Iterator[T]{
+		ctx: ctx,
+		fetch: fetch,
+		timestamp: timestamp,
+		options: options,
+	}
+}
+
+// Advances to the next item, fetching the next page if needed; returns false once iteration is over
+func (it *Iterator[T]) Next() bool {
+	for !it.done {
+		if it.options.MaxItems > 0 && it.count >= it.options.MaxItems {
+			break
+		}
+		if it.index < len(it.page) {
+			item := it.page[it.index]
+			it.index++
+			if !it.options.StopBefore.IsZero() && it.timestamp != nil {
+				if ts := it.timestamp(item); ts > 0 && ts < it.options.StopBefore.Unix() {
+					break
+				}
+			}
+			it.item = item
+			it.count++
+			return true
+		}
+		if it.fetch == nil {
+			break
+		}
+		page, next, err := it.fetch(it.ctx)
+		if err != nil {
+			if err != NoNextPageError {
+				it.err = err
+			}
+			break
+		}
+		if len(page) < 1 {
+			break
+		}
+		it.page, it.index, it.fetch = page, 0, next
+	}
+	it.done = true
+	return false
+}
+
+// Returns the current item, valid after a call to Next() returns true
+func (it *Iterator[T]) Item() T {
+	return it.item
+}
+
+// Returns the error which ended iteration, if any
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// Builds a pageFetcher from a function retrieving a page, a function listing its items,
+// and a function producing the retrieval of the following page (which may fail with NoNextPageError)
+func paginate[P any, T any](get func(context.Context) (P, error), items func(P) ([]T, error), next func(P) func(context.Context) (P, error)) pageFetcher[T] {
+	return func(ctx context.Context) ([]T, pageFetcher[T], error) {
+		page, err := get(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		list, err := items(page)
+		if err != nil {
+			return nil, nil, err
+		}
+		return list, paginate(next(page), items, next), nil
+	}
+}
+
+// Page retrieval used once a collection has no further pages
+func noNextPage[P any](context.Context) (P, error) {
+	var page P
+	return page, NoNextPageError
+}
+
+// Copies params, setting the `limit` param if a page size was given
+func pageParams(params url.Values, options IteratorOptions) url.Values {
+	params = copyParams(params)
+	if options.PageSize > 0 {
+		params.Set("limit", strconv.Itoa(options.PageSize))
+	}
+	return params
+}
+
+// Post timestamp used for StopBefore, preferring the featured timestamp when present
+func postTimestamp(p PostInterface) int64 {
+	post := p.GetSelf()
+	if post.FeaturedTimestamp > 0 {
+		return int64(post.FeaturedTimestamp)
+	}
+	return int64(post.Timestamp)
+}
+
+// Time a post was liked, used for StopBefore when iterating over likes
+func likedTimestamp(p PostInterface) int64 {
+	if ts := p.GetSelf().LikedTimestamp; ts > 0 {
+		return int64(ts)
+	}
+	return postTimestamp(p)
+}
+
+// Iterates over all of a blog's posts
+func IteratePosts(client ClientInterface, name string, params url.Values, options IteratorOptions) *Iterator[PostInterface] {
+	return IteratePostsContext(context.Background(), client, name, params, options)
+}
+
+// Iterates over all of a blog's posts, aborting if ctx is done
+func IteratePostsContext(ctx context.Context, client ClientInterface, name string, params url.Values, options IteratorOptions) *Iterator[PostInterface] {
+	params = pageParams(params, options)
+	return iteratePosts(ctx, func(ctx context.Context) (*Posts, error) {
+		return GetPostsContext(ctx, client, name, params)
+	}, options)
+}
+
+// Iterates over every page of posts following the one retrieved by get
+func iteratePosts(ctx context.Context, get func(context.Context) (*Posts, error), options IteratorOptions) *Iterator[PostInterface] {
+	fetch := paginate(
+		get,
+		(*Posts).All,
+		func(p *Posts) func(context.Context) (*Posts, error) {
+			return p.NextContext
+		},
+	)
+	return newIterator(ctx, fetch, postTimestamp, options)
+}
+
+// Iterates over all of a blog's submissions, including asks. Submissions can only be paged by offset, and
+// accepting or rejecting them during iteration shifts the ones after them forward, so each page is requested
+// again from the same offset until it holds no unseen submissions; iterating without removing any therefore
+// costs an extra request per page.
+func IterateSubmissions(client ClientInterface, name string, params url.Values, options IteratorOptions) *Iterator[PostInterface] {
+	return IterateSubmissionsContext(context.Background(), client, name, params, options)
+}
+
+// Iterates over all of a blog's submissions, aborting if ctx is done
+func IterateSubmissionsContext(ctx context.Context, client ClientInterface, name string, params url.Values, options IteratorOptions) *Iterator[PostInterface] {
+	params = pageParams(params, options)
+	offset, _ := strconv.Atoi(params.Get("offset"))
+	seen := map[uint64]bool{}
+	var fetchFrom func(offset int) pageFetcher[PostInterface]
+	fetchFrom = func(offset int) pageFetcher[PostInterface] {
+		return func(ctx context.Context) ([]PostInterface, pageFetcher[PostInterface], error) {
+			for {
+				params.Set("offset", strconv.Itoa(offset))
+				posts, err := GetSubmissionsContext(ctx, client, name, params)
+				if err != nil {
+					return nil, nil, err
+				}
+				all, err := posts.All()
+				if err != nil {
+					return nil, nil, err
+				}
+				unseen := []PostInterface{}
+				for _, post := range all {
+					if id := post.GetSelf().Id; !seen[id] {
+						seen[id] = true
+						unseen = append(unseen, post)
+					}
+				}
+				last := posts.isLastPage() || posts.TotalPosts > 0 && int64(offset + len(all)) >= posts.TotalPosts
+				if len(unseen) > 0 {
+					if last {
+						return unseen, nil, nil
+					}
+					return unseen, fetchFrom(offset), nil
+				}
+				if last {
+					return nil, nil, nil
+				}
+				offset += len(all)
+			}
+		}
+	}
+	return newIterator(ctx, fetchFrom(offset), postTimestamp, options)
+}
+
+// Iterates over all of the user's liked posts
+func IterateLikes(client ClientInterface, params url.Values, options IteratorOptions) *Iterator[PostInterface] {
+	return IterateLikesContext(context.Background(), client, params, options)
+}
+
+// Iterates over all of the user's liked posts, aborting if ctx is done
+func IterateLikesContext(ctx context.Context, client ClientInterface, params url.Values, options IteratorOptions) *Iterator[PostInterface] {
+	params = pageParams(params, options)
+	return iterateLikes(ctx, func(ctx context.Context) (*Likes, error) {
+		return GetLikesContext(ctx, client, params)
+	}, options)
+}
+
+// Iterates over every page of likes following the one retrieved by get
+func iterateLikes(ctx context.Context, get func(context.Context) (*Likes, error), options IteratorOptions) *Iterator[PostInterface] {
+	fetch := paginate(
+		get,
+		(*Likes).Full,
+		func(l *Likes) func(context.Context) (*Likes, error) {
+			return l.NextContext
+		},
+	)
+	return newIterator(ctx, fetch, likedTimestamp, options)
+}
+
+// Iterates over all of the posts liked by a blog
+func IterateBlogLikes(client ClientInterface, name string, params url.Values, options IteratorOptions) *Iterator[PostInterface] {
+	return IterateBlogLikesContext(context.Background(), client, name, params, options)
+}
+
+// Iterates over all of the posts liked by a blog, aborting if ctx is done
+func IterateBlogLikesContext(ctx context.Context, client ClientInterface, name string, params url.Values, options IteratorOptions) *Iterator[PostInterface] {
+	params = pageParams(params, options)
+	return iterateLikes(ctx, func(ctx context.Context) (*Likes, error) {
+		return GetBlogLikesContext(ctx, client, name, params)
+	}, options)
+}
+
+// Iterates over the user's dashboard, paginating by since_id if params specify it and by offset otherwise
+func IterateDashboard(client ClientInterface, params url.Values, options IteratorOptions) *Iterator[PostInterface] {
+	return IterateDashboardContext(context.Background(), client, params, options)
+}
+
+// Iterates over the user's dashboard, aborting if ctx is done
+func IterateDashboardContext(ctx context.Context, client ClientInterface, params url.Values, options IteratorOptions) *Iterator[PostInterface] {
+	params = pageParams(params, options)
+	fetch := paginate(
+		func(ctx context.Context) (*Dashboard, error) {
+			return GetDashboardContext(ctx, client, params)
+		},
+		func(d *Dashboard) ([]PostInterface, error) {
+			return d.Posts, nil
+		},
+		func(d *Dashboard) func(context.Context) (*Dashboard, error) {
+			if d.bySince {
+				return d.NextBySinceIdContext
+			}
+			return d.NextByOffsetContext
+		},
+	)
+	return newIterator(ctx, fetch, postTimestamp, options)
+}
+
+// Iterates over all posts with the given tag
+func IterateTagged(client ClientInterface, tag string, params url.Values, options IteratorOptions) *Iterator[PostInterface] {
+	return IterateTaggedContext(context.Background(), client, tag, params, options)
+}
+
+// Iterates over all posts with the given tag, aborting if ctx is done
+func IterateTaggedContext(ctx context.Context, client ClientInterface, tag string, params url.Values, options IteratorOptions) *Iterator[PostInterface] {
+	params = pageParams(params, options)
+	fetch := paginate(
+		func(ctx context.Context) (*SearchResults, error) {
+			return TaggedSearchContext(ctx, client, tag, params)
+		},
+		func(s *SearchResults) ([]PostInterface, error) {
+			return s.Posts, nil
+		},
+		func(s *SearchResults) func(context.Context) (*SearchResults, error) {
+			// Next() modifies the result's params in place, so give each page its own copy
+			s.params = copyParams(s.params)
+			return s.NextContext
+		},
+	)
+	return newIterator(ctx, fetch, postTimestamp, options)
+}
+
+// Iterates over all of a blog's followers, most recent first; StopBefore is not supported, as
+// Follower.Updated is when the follower last posted rather than when they followed
+func IterateFollowers(client ClientInterface, name string, options IteratorOptions) *Iterator[Follower] {
+	return IterateFollowersContext(context.Background(), client, name, options)
+}
+
+// Iterates over all of a blog's followers, aborting if ctx is done
+func IterateFollowersContext(ctx context.Context, client ClientInterface, name string, options IteratorOptions) *Iterator[Follower] {
+	fetch := paginate(
+		func(ctx context.Context) (*FollowerList, error) {
+			return GetFollowersContext(ctx, client, name, 0, uint(options.PageSize))
+		},
+		func(f *FollowerList) ([]Follower, error) {
+			return f.Followers, nil
+		},
+		func(f *FollowerList) func(context.Context) (*FollowerList, error) {
+			return f.NextContext
+		},
+	)
+	return newIterator(ctx, fetch, nil, options)
+}
+
+// Iterates over all blogs the user follows; StopBefore is not supported, as Blog.Updated
+// is when the blog last posted rather than when it was followed
+func IterateFollowing(client ClientInterface, options IteratorOptions) *Iterator[Blog] {
+	return IterateFollowingContext(context.Background(), client, options)
+}
+
+// Iterates over all blogs the user follows, aborting if ctx is done
+func IterateFollowingContext(ctx context.Context, client ClientInterface, options IteratorOptions) *Iterator[Blog] {
+	fetch := paginate(
+		func(ctx context.Context) (*FollowingList, error) {
+			return GetFollowingContext(ctx, client, 0, uint(options.PageSize))
+		},
+		func(f *FollowingList) ([]Blog, error) {
+			return f.Blogs, nil
+		},
+		func(f *FollowingList) func(context.Context) (*FollowingList, error) {
+			return f.NextContext
+		},
+	)
+	return newIterator(ctx, fetch, nil, options)
+}
+
+// Iterates over all of a post's notes
+func IterateNotes(client ClientInterface, name string, postId uint64, mode NotesMode, options IteratorOptions) *Iterator[NoteInterface] {
+	return IterateNotesContext(context.Background(), client, name, postId, mode, options)
+}
+
+// Iterates over all of a post's notes, aborting if ctx is done
+func IterateNotesContext(ctx context.Context, client ClientInterface, name string, postId uint64, mode NotesMode, options IteratorOptions) *Iterator[NoteInterface] {
+	fetch := paginate(
+		func(ctx context.Context) (*Notes, error) {
+			return GetNotesContext(ctx, client, name, postId, mode)
+		},
+		func(n *Notes) ([]NoteInterface, error) {
+			return n.Notes, nil
+		},
+		func(n *Notes) func(context.Context) (*Notes, error) {
+			return n.NextContext
+		},
+	)
+	return newIterator(ctx, fetch, func(n NoteInterface) int64 {
+		return n.GetSelf().Timestamp
+	}, options)
+}
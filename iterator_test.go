@@ -0,0 +1,257 @@
+package tumblr
+
+import (
+	"testing"
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+	"fmt"
+	"strconv"
+)
+
+// Serves the given bodies in order, recording the params of each request
+func sequenceBodies(client *testClient, bodies ...string) *[]url.Values {
+	requests := []url.Values{}
+	client.confirmExpectedSet = func(method, path string, params url.Values) {
+		client.response = Response{body: []byte(bodies[len(requests)])}
+		requests = append(requests, copyParams(params))
+	}
+	return &requests
+}
+
+func getPostsString(total int, posts ...Post) string {
+	return jsonStringify(map[string]interface{}{
+		"response": map[string]interface{}{
+			"posts": posts,
+			"total_posts": total,
+		},
+	})
+}
+
+func textPost(id, timestamp uint64) Post {
+	return Post{PostRef: PostRef{MiniPost: MiniPost{Id: id, Type: "text"}}, Timestamp: timestamp}
+}
+
+func TestIteratePosts(t *testing.T) {
+	client := newTestClient("{}", nil)
+	requests := sequenceBodies(
+		client,
+		getPostsString(3, textPost(1, 300), textPost(2, 200)),
+		getPostsString(3, textPost(3, 100)),
+	)
+	it := IteratePosts(client, "david", url.Values{"tag": []string{"cats"}}, IteratorOptions{PageSize: 2})
+	ids := []uint64{}
+	for it.Next() {
+		if _, ok := it.Item().(*TextPost); !ok {
+			t.Fatal("Iterator should produce typed posts")
+		}
+		ids = append(ids, it.Item().GetSelf().Id)
+	}
+	if it.Err() != nil {
+		t.Fatal("Unexpected error", it.Err())
+	}
+	if len(ids) != 3 || ids[0] != 1 || ids[2] != 3 {
+		t.Fatal("Iterator should walk every page", ids)
+	}
+	if len(*requests) != 2 {
+		t.Fatal("Iterator should stop once the total has been reached", *requests)
+	}
+	second := (*requests)[1]
+	if second.Get("offset") != "2" || second.Get("limit") != "2" || second.Get("tag") != "cats" {
+		t.Fatal("Next page should advance the offset and keep the filters", second)
+	}
+	if it.Next() {
+		t.Fatal("Finished iterator should stay finished")
+	}
+}
+
+func TestIteratorMaxItems(t *testing.T) {
+	client := newTestClient("{}", nil)
+	requests := sequenceBodies(client, getPostsString(10, textPost(1, 300), textPost(2, 200)))
+	it := IteratePosts(client, "david", url.Values{}, IteratorOptions{MaxItems: 1})
+	count := 0
+	for it.Next() {
+		count++
+	}
+	if count != 1 || len(*requests) != 1 {
+		t.Fatal("Iterator should stop after MaxItems")
+	}
+}
+
+func TestIteratorStopBefore(t *testing.T) {
+	client := newTestClient("{}", nil)
+	sequenceBodies(client, getPostsString(10, textPost(1, 300), textPost(2, 200), textPost(3, 100)))
+	it := IteratePosts(client, "david", url.Values{}, IteratorOptions{StopBefore: time.Unix(150, 0)})
+	count := 0
+	for it.Next() {
+		count++
+	}
+	if count != 2 {
+		t.Fatal("Iterator should stop at the first item older than StopBefore", count)
+	}
+}
+
+func TestIteratorError(t *testing.T) {
+	clientErr := errors.New("Client error")
+	client := newTestClient("{}", clientErr)
+	it := IterateDashboard(client, url.Values{}, IteratorOptions{})
+	if it.Next() {
+		t.Fatal("Iterator should not produce items after an error")
+	}
+	if it.Err() != clientErr {
+		t.Fatal("Iterator should expose the error which ended it")
+	}
+}
+
+func TestIterateDashboard(t *testing.T) {
+	client := newTestClient("{}", nil)
+	requests := sequenceBodies(client, getDashString(textPost(1, 300), textPost(2, 200)), getDashString())
+	it := IterateDashboard(client, url.Values{}, IteratorOptions{})
+	count := 0
+	for it.Next() {
+		count++
+	}
+	if count != 2 || len(*requests) != 2 || (*requests)[1].Get("offset") != "2" {
+		t.Fatal("Dashboard should be paginated by offset", *requests)
+	}
+}
+
+func TestIterateTagged(t *testing.T) {
+	client := newTestClient("{}", nil)
+	page := jsonStringify(map[string]interface{}{
+		"response": []Post{textPost(1, 300), textPost(2, 200)},
+	})
+	requests := sequenceBodies(client, page, "{}")
+	it := IterateTagged(client, "cats", url.Values{}, IteratorOptions{})
+	count := 0
+	for it.Next() {
+		count++
+	}
+	if count != 2 || len(*requests) != 2 || (*requests)[1].Get("before") != "200" || (*requests)[0].Get("before") != "" {
+		t.Fatal("Tagged search should be paginated by timestamp", *requests)
+	}
+}
+
+func TestIterateFollowers(t *testing.T) {
+	client := newTestClient("{}", nil)
+	page := func(names ...string) string {
+		users := []Follower{}
+		for _, name := range names {
+			users = append(users, Follower{Name: name})
+		}
+		return jsonStringify(map[string]interface{}{
+			"response": map[string]interface{}{
+				"total_users": 3,
+				"users": users,
+			},
+		})
+	}
+	requests := sequenceBodies(client, page("a", "b"), page("c"))
+	client.confirmExpectedSet = func(set func(string, string, url.Values)) func(string, string, url.Values) {
+		return func(method, path string, params url.Values) {
+			if method != http.MethodGet || path != blogPath("/blog/%s/followers", "david") {
+				t.Fatal("Unexpected followers request", method, path)
+			}
+			set(method, path, params)
+		}
+	}(client.confirmExpectedSet)
+	it := IterateFollowers(client, "david", IteratorOptions{PageSize: 2})
+	names := ""
+	for it.Next() {
+		names += it.Item().Name
+	}
+	if names != "abc" || (*requests)[1].Get("offset") != "2" {
+		t.Fatal("Followers should be paginated by offset", names, *requests)
+	}
+}
+
+func TestIterateFollowing(t *testing.T) {
+	client := newTestClient("{}", nil)
+	sequenceBodies(client, getFollowerString(3, Blog{Updated: 300}, Blog{Updated: 100}), getFollowerString(3, Blog{Updated: 200}))
+	it := IterateFollowing(client, IteratorOptions{StopBefore: time.Unix(150, 0)})
+	count := 0
+	for it.Next() {
+		count++
+	}
+	if count != 3 {
+		t.Fatal("Following should walk every page, ignoring when blogs last posted", count)
+	}
+}
+
+func TestIterateNotes(t *testing.T) {
+	client := newTestClient("{}", nil)
+	requests := sequenceBodies(client, notesJson, `{"response": {"notes": [{"type": "like", "timestamp": 10}]}}`)
+	it := IterateNotes(client, "david", 1986, NotesAll, IteratorOptions{})
+	count := 0
+	for it.Next() {
+		count++
+	}
+	if it.Err() != nil || count != 6 || (*requests)[1].Get("before_timestamp") != "99" {
+		t.Fatal("Notes should be paginated by timestamp", count, it.Err(), *requests)
+	}
+}
+
+func TestIterateSubmissions(t *testing.T) {
+	client := newTestClient("{}", nil)
+	requests := sequenceBodies(client, inboxJson)
+	client.confirmExpectedSet = func(set func(string, string, url.Values)) func(string, string, url.Values) {
+		return func(method, path string, params url.Values) {
+			if path != blogPath("/blog/%s/posts/submission", "david") {
+				t.Fatal("Unexpected submissions request", path)
+			}
+			set(method, path, params)
+		}
+	}(client.confirmExpectedSet)
+	it := IterateSubmissions(client, "david", url.Values{}, IteratorOptions{})
+	count := 0
+	for it.Next() {
+		count++
+	}
+	if it.Err() != nil || count != 3 || len(*requests) != 1 {
+		t.Fatal("Submissions should be iterated until the total is reached", count, it.Err())
+	}
+}
+
+func TestIterateSubmissionsWhileAccepting(t *testing.T) {
+	client := newTestClient("{}", nil)
+	queue := []uint64{1, 2, 3, 4, 5}
+	client.confirmExpectedSet = func(method, path string, params url.Values) {
+		if method == http.MethodPost {
+			id, _ := strconv.ParseUint(params.Get("id"), 10, 64)
+			for i, queued := range queue {
+				if queued == id {
+					queue = append(queue[:i], queue[i + 1:]...)
+					break
+				}
+			}
+			client.response = Response{body: []byte("{}")}
+			return
+		}
+		offset, _ := strconv.Atoi(params.Get("offset"))
+		page := []Post{}
+		for i := offset; i < len(queue) && i < offset + 2; i++ {
+			page = append(page, textPost(queue[i], 0))
+		}
+		client.response = Response{body: []byte(getPostsString(len(queue), page...))}
+	}
+	it := IterateSubmissions(client, "david", url.Values{}, IteratorOptions{PageSize: 2})
+	seen := []uint64{}
+	for it.Next() {
+		post := it.Item().GetSelf()
+		post.BlogName = "david"
+		seen = append(seen, post.Id)
+		// accept every other submission, leaving the rest in the queue
+		if post.Id % 2 == 1 {
+			if err := post.AcceptSubmission(StatePublished, nil); err != nil {
+				t.Fatal("Failed to accept submission", err)
+			}
+		}
+	}
+	if it.Err() != nil || fmt.Sprint(seen) != "[1 2 3 4 5]" {
+		t.Fatal("Every submission should be visited once while others are accepted", seen, it.Err())
+	}
+	if fmt.Sprint(queue) != "[2 4]" {
+		t.Fatal("Accepted submissions should be removed", queue)
+	}
+}
@@ -1,16 +1,27 @@
 package tumblr
 
 import (
+	"context"
+	"net/http"
 	"net/url"
 	"encoding/json"
+	"strconv"
 )
 
 type Likes struct {
 	client ClientInterface
-	response *Response
-	parsedPosts []PostInterface
+	path string
+	params url.Values
+	// The undecoded liked posts, kept so that Full() can report a malformed post without failing the whole page
+	rawPosts json.RawMessage
 	Posts []MiniPost `json:"liked_posts"`
+	// The same posts decoded into their concrete types (QuotePost, PhotoPost, etc) once Full() has been called
+	FullPosts PostList `json:"-"`
 	TotalLikes uint64 `json:"liked_count"`
+	Links struct {
+		Next *Link `json:"next"`
+		Prev *Link `json:"prev"`
+	} `json:"_links"`
 }
 
 // Retrieves a Users's list of Posts they have liked
@@ -20,7 +31,28 @@ type Likes struct {
 //	before (timestamp)
 //	after (timestamp)
 func GetLikes(client ClientInterface, params url.Values) (*Likes, error) {
-	response, err := client.GetWithParams("/user/likes", params)
+	return GetLikesContext(context.Background(), client, params)
+}
+
+// Retrieves a User's list of liked Posts, aborting if ctx is done
+func GetLikesContext(ctx context.Context, client ClientInterface, params url.Values) (*Likes, error) {
+	return queryLikes(ctx, client, "/user/likes", params)
+}
+
+// Retrieves the list of Posts liked by a blog, which is only possible for blogs sharing their likes (see Blog.ShareLikes).
+// Accepts the same URL values as GetLikes.
+func GetBlogLikes(client ClientInterface, name string, params url.Values) (*Likes, error) {
+	return GetBlogLikesContext(context.Background(), client, name, params)
+}
+
+// Retrieves the list of Posts liked by a blog, aborting if ctx is done
+func GetBlogLikesContext(ctx context.Context, client ClientInterface, name string, params url.Values) (*Likes, error) {
+	return queryLikes(ctx, client, blogPath("/blog/%s/likes", name), params)
+}
+
+// helper method for querying a given path which should return a list of liked posts
+func queryLikes(ctx context.Context, client ClientInterface, path string, params url.Values) (*Likes, error) {
+	response, err := doRequest(ctx, client, http.MethodGet, path, params)
 	if err != nil {
 		return nil, err
 	}
@@ -32,43 +64,145 @@ func GetLikes(client ClientInterface, params url.Values) (*Likes, error) {
 		return nil, err
 	}
 	result.Response.client = client
-	result.Response.response = &response
+	result.Response.path = path
+	result.Response.params = copyParams(params)
 	return &result.Response, nil
 }
 
+// Retrieves the next (older) page of likes, requesting those liked before the last post on this page.
+// Offset paging of likes is capped by the API, so the `before` timestamp is used instead.
+func (l *Likes) Next() (*Likes, error) {
+	return l.NextContext(context.Background())
+}
+
+// Retrieves the next page of likes, aborting if ctx is done
+func (l *Likes) NextContext(ctx context.Context) (*Likes, error) {
+	if len(l.Posts) < 1 {
+		return nil, NoNextPageError
+	}
+	before := ""
+	if l.Links.Next != nil {
+		before = l.Links.Next.QueryParams["before"]
+	}
+	if before == "" {
+		ts := l.Posts[len(l.Posts) - 1].LikedTimestamp
+		if ts < 1 {
+			return nil, NoNextPageError
+		}
+		before = strconv.FormatUint(ts, 10)
+	}
+	params := copyParams(l.params)
+	params.Del("offset")
+	params.Del("after")
+	params.Set("before", before)
+	return queryLikes(ctx, l.client, l.path, params)
+}
+
+// Retrieves the previous (newer) page of likes, requesting those liked after the first post on this page
+func (l *Likes) Prev() (*Likes, error) {
+	return l.PrevContext(context.Background())
+}
+
+// Retrieves the previous page of likes, aborting if ctx is done
+func (l *Likes) PrevContext(ctx context.Context) (*Likes, error) {
+	if len(l.Posts) < 1 {
+		return nil, NoPrevPageError
+	}
+	after := ""
+	if l.Links.Prev != nil {
+		after = l.Links.Prev.QueryParams["after"]
+	}
+	if after == "" {
+		ts := l.Posts[0].LikedTimestamp
+		if ts < 1 {
+			return nil, NoPrevPageError
+		}
+		after = strconv.FormatUint(ts, 10)
+	}
+	params := copyParams(l.params)
+	params.Del("offset")
+	params.Del("before")
+	params.Set("after", after)
+	return queryLikes(ctx, l.client, l.path, params)
+}
+
 // Convenience method for performing a like/unlike operation
-func doLike(client ClientInterface, path string, postId uint64, reblogKey string) error {
+func doLike(ctx context.Context, client ClientInterface, path string, postId uint64, reblogKey string) error {
 	params := url.Values{}
 	params.Set("reblog_key", reblogKey)
-	_, err := client.PostWithParams(path, setPostId(postId, params))
+	_, err := doRequest(ctx, client, http.MethodPost, path, setPostId(postId, params))
 	return err
 }
 
 // Like a post on behalf of a user
 func LikePost(client ClientInterface, postId uint64, reblogKey string) error {
-	return doLike(client, "/user/like", postId, reblogKey)
+	return LikePostContext(context.Background(), client, postId, reblogKey)
+}
+
+// Like a post on behalf of a user, aborting if ctx is done
+func LikePostContext(ctx context.Context, client ClientInterface, postId uint64, reblogKey string) error {
+	return doLike(ctx, client, "/user/like", postId, reblogKey)
 }
 
 // Unlike a post on behalf of a user
 func UnlikePost(client ClientInterface, postId uint64, reblogKey string) error {
-	return doLike(client, "/user/unlike", postId, reblogKey)
-}
-
-// Return an array of full post objects (instead of the default array of MiniPosts initially created)
-func (l *Likes)Full() ([]PostInterface, error) {
-	var err error = nil
-	if l.parsedPosts == nil {
-		r := struct {
-			Response struct{
-					 Posts []PostInterface `json:"liked_posts"`
-				 } `json:"response"`
-		}{}
-		r.Response.Posts = makePostsFromMinis(l.Posts, l.client)
-		if err = json.Unmarshal(l.response.body, &r); err != nil {
-			l.parsedPosts = []PostInterface{}
-		} else {
-			l.parsedPosts = r.Response.Posts
-		}
+	return UnlikePostContext(context.Background(), client, postId, reblogKey)
+}
+
+// Unlike a post on behalf of a user, aborting if ctx is done
+func UnlikePostContext(ctx context.Context, client ClientInterface, postId uint64, reblogKey string) error {
+	return doLike(ctx, client, "/user/unlike", postId, reblogKey)
+}
+
+// Return an array of full post objects (instead of the default array of MiniPosts initially created);
+// they are decoded on first use, or created from the stubs for a list built by hand
+func (l *Likes) Full() ([]PostInterface, error) {
+	if l.FullPosts != nil {
+		return l.FullPosts, nil
+	}
+	if l.rawPosts == nil {
+		l.FullPosts = makePostsFromMinis(l.Posts, l.client)
+		return l.FullPosts, nil
+	}
+	full, err := decodePostList(l.rawPosts, l.client)
+	if err != nil {
+		return nil, err
+	}
+	l.FullPosts = full
+	return l.FullPosts, nil
+}
+
+// Decodes the liked posts as stubs, keeping them undecoded for Full()
+func (l *Likes) UnmarshalJSON(data []byte) error {
+	type likes Likes
+	if err := json.Unmarshal(data, (*likes)(l)); err != nil {
+		return err
+	}
+	raw := struct {
+		Posts json.RawMessage `json:"liked_posts"`
+	}{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	l.rawPosts = raw.Posts
+	l.FullPosts = nil
+	return nil
+}
+
+// Encodes the full posts in place of the stubs, so they survive re-encoding
+func (l Likes) MarshalJSON() ([]byte, error) {
+	type likes Likes
+	if l.FullPosts != nil {
+		return json.Marshal(struct {
+			likes
+			Posts PostList `json:"liked_posts"`
+		}{likes(l), l.FullPosts})
+	}
+	if l.rawPosts != nil {
+		return json.Marshal(struct {
+			likes
+			Posts json.RawMessage `json:"liked_posts"`
+		}{likes(l), l.rawPosts})
 	}
-	return l.parsedPosts, err
+	return json.Marshal(likes(l))
 }
@@ -1,6 +1,10 @@
 package tumblr
 
 import (
+	"fmt"
+	"encoding/json"
+	"strings"
+	"context"
 	"testing"
 	"errors"
 	"net/url"
@@ -34,8 +38,8 @@ func TestGetLikesSuccess(t *testing.T) {
 	)
 	if response, err := GetLikes(client, params); err != nil || response == nil {
 		t.Fatal("Request should succeed")
-	} else if response.response == nil {
-		t.Fatal("Response should be set")
+	} else if response.path != "/user/likes" {
+		t.Fatal("Response should keep its path for paging")
 	} else if response.client != client {
 		t.Fatal("Response should set client")
 	}
@@ -56,7 +60,7 @@ func TestDoLikeError(t *testing.T) {
 		path,
 		params,
 	)
-	if err := doLike(client, path, postId, reblogKey); err != clientErr {
+	if err := doLike(context.Background(), client, path, postId, reblogKey); err != clientErr {
 		t.Fatal("Client error should be returned")
 	}
 }
@@ -75,7 +79,7 @@ func TestDoLikeSuccess(t *testing.T) {
 		path,
 		params,
 	)
-	if err := doLike(client, path, postId, reblogKey); err != nil {
+	if err := doLike(context.Background(), client, path, postId, reblogKey); err != nil {
 		t.Fatal("No error should be generated")
 	}
 }
@@ -116,35 +120,151 @@ func TestUnlike(t *testing.T) {
 	}
 }
 
+func TestFullLikesDecodesConcreteTypes(t *testing.T) {
+	client := newTestClient(`{"response": {"liked_posts": [{"id": 1, "type": "quote", "text": "Hi"}]}}`, nil)
+	response, err := GetLikes(client, url.Values{})
+	if err != nil {
+		t.Fatal("Unable to get likes", err)
+	}
+	full, err := response.Full()
+	if err != nil || len(full) != 1 {
+		t.Fatal("Full like posts should be returned", err)
+	}
+	if quote, ok := full[0].(*QuotePost); !ok || quote.Text != "Hi" || quote.client != client {
+		t.Fatal("Full like posts should be decoded into their concrete types", full[0])
+	}
+}
+
 func TestFullLikesWithJsonError(t *testing.T) {
-	client := newTestClient("{}", nil)
-	response, _ := GetLikes(client, url.Values{})
-	if response == nil {
-		t.Fatal("Unable to get likes")
+	client := newTestClient(`{"response": {"liked_posts": [{"id": 1, "type": "quote", "text": 5}]}}`, nil)
+	response, err := GetLikes(client, url.Values{})
+	if err != nil || response == nil {
+		t.Fatal("A malformed post should not fail the whole page", err)
 	}
-	if response.parsedPosts != nil {
-		t.Fatal("Parsed posts should start out uninitialized")
+	if response.FullPosts != nil {
+		t.Fatal("Full posts should start out uninitialized")
 	}
-	response.response.body = []byte("{")
-	_, err := response.Full()
+	_, err = response.Full()
 	if err == nil {
 		t.Fatal("JSON Unmarshal failure should be returned")
 	}
-
 }
 
-func TestFullLikesSuccess(t *testing.T) {
-	client := newTestClient("{}", nil)
+func TestFullLikesRoundTrip(t *testing.T) {
+	client := newTestClient(`{"response": {"liked_count": 1, "liked_posts": [{"id": 1, "type": "quote", "text": "Hi"}]}}`, nil)
 	response, _ := GetLikes(client, url.Values{})
-	if response == nil {
-		t.Fatal("Unable to get likes")
+	encoded, err := json.Marshal(response)
+	if err != nil {
+		t.Fatal("Failed to encode likes", err)
+	}
+	decoded := Likes{}
+	if err = json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatal("Failed to decode likes", err)
+	}
+	full, err := decoded.Full()
+	if err != nil || len(full) != 1 || decoded.TotalLikes != 1 || len(decoded.Posts) != 1 {
+		t.Fatal("Likes should survive re-encoding", err)
+	}
+	if quote, ok := full[0].(*QuotePost); !ok || quote.Text != "Hi" {
+		t.Fatal("Full like posts should survive re-encoding", full[0])
+	}
+	byHand := Likes{Posts: []MiniPost{MiniPost{Id: 2, Type: "text"}}}
+	if full, err := byHand.Full(); err != nil || len(full) != 1 || full[0].GetSelf().Id != 2 {
+		t.Fatal("Full posts should be built from stubs set by hand", err)
+	}
+}
+
+func getLikesString(links string, timestamps ...uint64) string {
+	posts := []string{}
+	for i, ts := range timestamps {
+		posts = append(posts, fmt.Sprintf(`{"id": %d, "type": "text", "liked_timestamp": %d}`, i + 1, ts))
+	}
+	return fmt.Sprintf(`{"response": {"liked_count": 100, "liked_posts": [%s], "_links": %s}}`, strings.Join(posts, ","), links)
+}
+
+func TestLikes_NextUsesLastLikedTimestamp(t *testing.T) {
+	client := newTestClient(getLikesString("{}", 300, 200), nil)
+	params := url.Values{}
+	params.Set("limit", "2")
+	params.Set("offset", "4")
+	likes, err := GetLikes(client, params)
+	if err != nil {
+		t.Fatal("Failed to get likes", err)
+	}
+	client.confirmExpectedSet = expectClientCallParams(t, "Likes.Next", http.MethodGet, "/user/likes", url.Values{
+		"limit": []string{"2"},
+		"before": []string{"200"},
+	})
+	next, err := likes.Next()
+	if err != nil {
+		t.Fatal("Next page of likes should be requested", err)
+	}
+	if likes.params.Get("before") != "" {
+		t.Fatal("Next page should not modify params of previous result")
 	}
-	if response.parsedPosts != nil {
-		t.Fatal("Parsed posts should start out uninitialized")
+	next.Posts = nil
+	if _, err := next.Next(); err != NoNextPageError {
+		t.Fatal("Empty page should have no next page")
 	}
-	_, err := response.Full()
+}
+
+func TestLikes_NextUsesLinks(t *testing.T) {
+	links := `{"next": {"href": "/v2/user/likes?before=150", "method": "GET", "query_params": {"before": "150"}}}`
+	client := newTestClient(getLikesString(links, 300, 200), nil)
+	likes, _ := GetLikes(client, url.Values{})
+	client.confirmExpectedSet = expectClientCallParams(t, "Likes.Next", http.MethodGet, "/user/likes", url.Values{
+		"before": []string{"150"},
+	})
+	if _, err := likes.Next(); err != nil {
+		t.Fatal("Next page of likes should be requested", err)
+	}
+}
+
+func TestLikes_NextUsesNumericLinks(t *testing.T) {
+	links := `{"next": {"href": "/v2/user/likes?before=150", "method": "GET", "query_params": {"before": 150}}}`
+	client := newTestClient(getLikesString(links, 300, 200), nil)
+	likes, err := GetLikes(client, url.Values{})
 	if err != nil {
-		t.Fatal("Full like posts should be returned")
+		t.Fatal("Likes with numeric link params should decode", err)
+	}
+	client.confirmExpectedSet = expectClientCallParams(t, "Likes.Next", http.MethodGet, "/user/likes", url.Values{
+		"before": []string{"150"},
+	})
+	if _, err := likes.Next(); err != nil {
+		t.Fatal("Next page of likes should be requested", err)
 	}
+}
+
+func TestLikes_Prev(t *testing.T) {
+	client := newTestClient(getLikesString("{}", 300, 200), nil)
+	likes, _ := GetLikes(client, url.Values{"before": []string{"500"}})
+	client.confirmExpectedSet = expectClientCallParams(t, "Likes.Prev", http.MethodGet, "/user/likes", url.Values{
+		"after": []string{"300"},
+	})
+	if _, err := likes.Prev(); err != nil {
+		t.Fatal("Previous page of likes should be requested", err)
+	}
+	likes.Posts = []MiniPost{MiniPost{}}
+	if _, err := likes.Prev(); err != NoPrevPageError {
+		t.Fatal("Posts without a liked timestamp cannot be paged back from")
+	}
+}
 
-}
\ No newline at end of file
+func TestGetBlogLikes(t *testing.T) {
+	client := newTestClient(getLikesString("{}", 300, 200), nil)
+	path := blogPath("/blog/%s/likes", "david")
+	client.confirmExpectedSet = expectClientCallParams(t, "GetBlogLikes", http.MethodGet, path, url.Values{})
+	likes, err := NewBlogRef(client, "david").GetLikes(url.Values{})
+	if err != nil {
+		t.Fatal("Failed to get blog likes", err)
+	}
+	if full, err := likes.Full(); err != nil || len(full) != 2 {
+		t.Fatal("Blog likes should be decoded into full posts", err)
+	}
+	client.confirmExpectedSet = expectClientCallParams(t, "Likes.Next", http.MethodGet, path, url.Values{
+		"before": []string{"200"},
+	})
+	if _, err = likes.Next(); err != nil {
+		t.Fatal("Next page should be requested from the blog's likes", err)
+	}
+}
@@ -0,0 +1,190 @@
+package tumblr
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// Which notes GetNotes retrieves
+type NotesMode string
+
+const (
+	NotesAll NotesMode = "all"
+	NotesLikes NotesMode = "likes"
+	NotesConversation NotesMode = "conversation"
+	NotesRollup NotesMode = "rollup"
+	NotesReblogsWithTags NotesMode = "reblogs_with_tags"
+)
+
+// A page of a post's notes
+type Notes struct {
+	client ClientInterface
+	name string
+	params url.Values
+	Notes NoteList `json:"notes"`
+	// Only present in rollup mode
+	RollupNotes NoteList `json:"rollup_notes,omitempty"`
+	TotalNotes uint64 `json:"total_notes"`
+	TotalLikes uint64 `json:"total_likes"`
+	TotalReblogs uint64 `json:"total_reblogs"`
+	Links struct {
+		Next *Link `json:"next"`
+	} `json:"_links"`
+}
+
+// NoteInterface for use in typed structures which could contain any of the below subtypes
+type NoteInterface interface {
+	GetSelf() *Note
+}
+
+// The common fields on any note, no matter what type
+type Note struct {
+	// like, reblog, reply or posted
+	Type string `json:"type"`
+	Timestamp int64 `json:"timestamp"`
+	BlogName string `json:"blog_name"`
+	BlogUuid string `json:"blog_uuid"`
+	BlogUrl string `json:"blog_url"`
+	Followed bool `json:"followed"`
+	AvatarShape string `json:"avatar_shape"`
+	// Avatar URLs keyed by size
+	Avatar map[string]string `json:"avatar,omitempty"`
+}
+
+// Note subtype
+type LikeNote struct {
+	Note
+}
+
+// Note subtype
+type ReblogNote struct {
+	Note
+	PostId string `json:"post_id"`
+	ReblogParentBlogName string `json:"reblog_parent_blog_name,omitempty"`
+	AddedText string `json:"added_text,omitempty"`
+	// Only present in reblogs_with_tags mode
+	Tags []string `json:"tags,omitempty"`
+}
+
+// Note subtype
+type ReplyNote struct {
+	Note
+	ReplyText string `json:"reply_text"`
+	Formatting []TextFormatting `json:"formatting,omitempty"`
+}
+
+// Note subtype
+type PostedNote struct {
+	Note
+}
+
+// Useful for converting a NoteInterface into a Note
+func (n *Note) GetSelf() *Note {
+	return n
+}
+
+// Utility function to create the proper instance of Note for the given type
+func makeNoteFromType(t string) NoteInterface {
+	switch t {
+	case "like":
+		return &LikeNote{}
+	case "reblog":
+		return &ReblogNote{}
+	case "reply":
+		return &ReplyNote{}
+	case "posted":
+		return &PostedNote{}
+	}
+	return &Note{}
+}
+
+// List of notes which decodes each note into the struct matching its type
+type NoteList []NoteInterface
+
+// Decodes each note into the struct matching its `type`; unknown types are decoded as a plain *Note
+func (l *NoteList) UnmarshalJSON(data []byte) error {
+	raw := []json.RawMessage{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	notes := make(NoteList, 0, len(raw))
+	for _, r := range raw {
+		typed := struct {
+			Type string `json:"type"`
+		}{}
+		if err := json.Unmarshal(r, &typed); err != nil {
+			return err
+		}
+		note := makeNoteFromType(typed.Type)
+		if err := json.Unmarshal(r, note); err != nil {
+			return err
+		}
+		notes = append(notes, note)
+	}
+	*l = notes
+	return nil
+}
+
+// Retrieves a page of a post's notes, most recent first
+func GetNotes(client ClientInterface, name string, postId uint64, mode NotesMode) (*Notes, error) {
+	return GetNotesContext(context.Background(), client, name, postId, mode)
+}
+
+// Retrieves a page of a post's notes, aborting if ctx is done
+func GetNotesContext(ctx context.Context, client ClientInterface, name string, postId uint64, mode NotesMode) (*Notes, error) {
+	params := setPostId(postId, url.Values{})
+	if mode != "" {
+		params.Set("mode", string(mode))
+	}
+	return queryNotes(ctx, client, name, params)
+}
+
+// helper method for querying a blog's notes endpoint
+func queryNotes(ctx context.Context, client ClientInterface, name string, params url.Values) (*Notes, error) {
+	response, err := doRequest(ctx, client, http.MethodGet, blogPath("/blog/%s/notes", name), params)
+	if err != nil {
+		return nil, err
+	}
+	result := struct {
+		Response Notes `json:"response"`
+	}{}
+	if err = json.Unmarshal(response.body, &result); err != nil {
+		return nil, err
+	}
+	result.Response.client = client
+	result.Response.name = name
+	result.Response.params = copyParams(params)
+	return &result.Response, nil
+}
+
+// Retrieves the next (older) page of notes using the `before_timestamp` the API links to
+func (n *Notes) Next() (*Notes, error) {
+	return n.NextContext(context.Background())
+}
+
+// Retrieves the next (older) page of notes, aborting if ctx is done
+func (n *Notes) NextContext(ctx context.Context) (*Notes, error) {
+	if n.Links.Next == nil || len(n.Notes) < 1 {
+		return nil, NoNextPageError
+	}
+	before := n.Links.Next.QueryParams["before_timestamp"]
+	if before == "" {
+		before = strconv.FormatInt(n.Notes[len(n.Notes) - 1].GetSelf().Timestamp, 10)
+	}
+	params := copyParams(n.params)
+	params.Set("before_timestamp", before)
+	return queryNotes(ctx, n.client, n.name, params)
+}
+
+// Retrieves the post's notes
+func (p *PostRef) Notes(mode NotesMode) (*Notes, error) {
+	return p.NotesContext(context.Background(), mode)
+}
+
+// Retrieves the post's notes, aborting if ctx is done
+func (p *PostRef) NotesContext(ctx context.Context, mode NotesMode) (*Notes, error) {
+	return GetNotesContext(ctx, p.client, p.BlogName, p.Id, mode)
+}
@@ -0,0 +1,100 @@
+package tumblr
+
+import (
+	"testing"
+	"net/http"
+	"net/url"
+)
+
+const notesJson = `{"response": {
+	"notes": [
+		{"type": "like", "timestamp": 300, "blog_name": "a", "blog_uuid": "t:a", "followed": true, "avatar": {"64": "https://example.com/64.png"}},
+		{"type": "reblog", "timestamp": 200, "blog_name": "b", "post_id": "1234", "reblog_parent_blog_name": "david", "added_text": "wow", "tags": ["cats"]},
+		{"type": "reply", "timestamp": 150, "blog_name": "c", "reply_text": "nice"},
+		{"type": "posted", "timestamp": 100, "blog_name": "david"},
+		{"type": "pinned", "timestamp": 50, "blog_name": "david"}
+	],
+	"total_notes": 5,
+	"total_likes": 1,
+	"total_reblogs": 1,
+	"_links": {"next": {"href": "/v2/blog/david/notes?id=1986&before_timestamp=99", "method": "GET", "query_params": {"id": "1986", "before_timestamp": "99"}}}
+}}`
+
+func TestGetNotes(t *testing.T) {
+	client := newTestClient(notesJson, nil)
+	client.confirmExpectedSet = expectClientCallParams(t, "GetNotes", http.MethodGet, blogPath("/blog/%s/notes", "david"), url.Values{
+		"id": []string{"1986"},
+		"mode": []string{"reblogs_with_tags"},
+	})
+	notes, err := GetNotes(client, "david", 1986, NotesReblogsWithTags)
+	if err != nil {
+		t.Fatal("Failed to get notes", err)
+	}
+	if len(notes.Notes) != 5 || notes.TotalNotes != 5 || notes.TotalLikes != 1 {
+		t.Fatal("Notes not decoded correctly", notes)
+	}
+	if like, ok := notes.Notes[0].(*LikeNote); !ok || like.BlogUuid != "t:a" || !like.Followed || like.Avatar["64"] == "" {
+		t.Fatal("Like note not decoded correctly", notes.Notes[0])
+	}
+	if reblog, ok := notes.Notes[1].(*ReblogNote); !ok || reblog.PostId != "1234" || reblog.AddedText != "wow" || len(reblog.Tags) != 1 {
+		t.Fatal("Reblog note not decoded correctly", notes.Notes[1])
+	}
+	if reply, ok := notes.Notes[2].(*ReplyNote); !ok || reply.ReplyText != "nice" {
+		t.Fatal("Reply note not decoded correctly", notes.Notes[2])
+	}
+	if _, ok := notes.Notes[3].(*PostedNote); !ok {
+		t.Fatal("Posted note not decoded correctly", notes.Notes[3])
+	}
+	if unknown := notes.Notes[4].GetSelf(); unknown.Type != "pinned" {
+		t.Fatal("Unknown notes should decode as a plain Note", unknown)
+	}
+}
+
+func TestNotes_Next(t *testing.T) {
+	client := newTestClient(notesJson, nil)
+	notes, err := GetNotes(client, "david", 1986, NotesAll)
+	if err != nil {
+		t.Fatal("Failed to get notes", err)
+	}
+	client.confirmExpectedSet = expectClientCallParams(t, "Notes.Next", http.MethodGet, blogPath("/blog/%s/notes", "david"), url.Values{
+		"id": []string{"1986"},
+		"mode": []string{"all"},
+		"before_timestamp": []string{"99"},
+	})
+	if _, err = notes.Next(); err != nil {
+		t.Fatal("Failed to get next page", err)
+	}
+	notes.Links.Next = nil
+	if _, err = notes.Next(); err != NoNextPageError {
+		t.Fatal("Notes without a next link should have no next page", err)
+	}
+}
+
+func TestNotes_NextNumericTimestamp(t *testing.T) {
+	body := `{"response": {"notes": [{"type": "like", "timestamp": 120}], "_links": {"next": {"href": "/v2/blog/david/notes?id=1986&before_timestamp=1506371380", "method": "GET", "query_params": {"id": 1986, "before_timestamp": 1506371380}}}}}`
+	client := newTestClient(body, nil)
+	notes, err := GetNotes(client, "david", 1986, NotesAll)
+	if err != nil {
+		t.Fatal("Notes with numeric link params should decode", err)
+	}
+	client.confirmExpectedSet = expectClientCallParams(t, "Notes.Next", http.MethodGet, blogPath("/blog/%s/notes", "david"), url.Values{
+		"id": []string{"1986"},
+		"mode": []string{"all"},
+		"before_timestamp": []string{"1506371380"},
+	})
+	if _, err = notes.Next(); err != nil {
+		t.Fatal("Failed to get next page", err)
+	}
+}
+
+func TestPostRef_Notes(t *testing.T) {
+	client := newTestClient(notesJson, nil)
+	ref := &PostRef{client: client, MiniPost: MiniPost{Id: 1986, BlogName: "david"}}
+	client.confirmExpectedSet = expectClientCallParams(t, "PostRef.Notes", http.MethodGet, blogPath("/blog/%s/notes", "david"), url.Values{
+		"id": []string{"1986"},
+		"mode": []string{"likes"},
+	})
+	if notes, err := ref.Notes(NotesLikes); err != nil || len(notes.Notes) != 5 {
+		t.Fatal("Failed to get notes", err)
+	}
+}
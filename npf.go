@@ -0,0 +1,355 @@
+package tumblr
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// A Neue Post Format content block; type switch on the concrete *TextBlock, *ImageBlock, etc to inspect it
+type ContentBlock interface {
+	// The block's `type` discriminator
+	GetType() string
+}
+
+// NPF text block
+type TextBlock struct {
+	Text string `json:"text"`
+	// heading1, heading2, quirky, quote, indented, chat, ordered-list-item or unordered-list-item
+	Subtype string `json:"subtype,omitempty"`
+	IndentLevel int `json:"indent_level,omitempty"`
+	Formatting []TextFormatting `json:"formatting,omitempty"`
+}
+
+// Text block substructure describing inline formatting over a range of the text
+type TextFormatting struct {
+	Start int `json:"start"`
+	End int `json:"end"`
+	// bold, italic, strikethrough, small, link, mention or color
+	Type string `json:"type"`
+	// Only present for link formatting
+	Url string `json:"url,omitempty"`
+	// Only present for mention formatting
+	Blog *BlogMention `json:"blog,omitempty"`
+	// Only present for color formatting
+	Hex string `json:"hex,omitempty"`
+}
+
+// Reference to a blog from within NPF content
+type BlogMention struct {
+	Uuid string `json:"uuid,omitempty"`
+	Name string `json:"name,omitempty"`
+	Url string `json:"url,omitempty"`
+}
+
+// NPF image block
+type ImageBlock struct {
+	// The same image at different sizes
+	Media []MediaObject `json:"media"`
+	Colors map[string]string `json:"colors,omitempty"`
+	FeedbackToken string `json:"feedback_token,omitempty"`
+	Poster *MediaObject `json:"poster,omitempty"`
+	Attribution *Attribution `json:"attribution,omitempty"`
+	AltText string `json:"alt_text,omitempty"`
+	Caption string `json:"caption,omitempty"`
+}
+
+// NPF link block
+type LinkBlock struct {
+	Url string `json:"url"`
+	Title string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	Author string `json:"author,omitempty"`
+	SiteName string `json:"site_name,omitempty"`
+	DisplayUrl string `json:"display_url,omitempty"`
+	Poster []MediaObject `json:"poster,omitempty"`
+}
+
+// NPF audio block
+type AudioBlock struct {
+	Url string `json:"url,omitempty"`
+	Media *MediaObject `json:"media,omitempty"`
+	Provider string `json:"provider,omitempty"`
+	Title string `json:"title,omitempty"`
+	Artist string `json:"artist,omitempty"`
+	Album string `json:"album,omitempty"`
+	Poster []MediaObject `json:"poster,omitempty"`
+	EmbedHtml string `json:"embed_html,omitempty"`
+	EmbedUrl string `json:"embed_url,omitempty"`
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	Attribution *Attribution `json:"attribution,omitempty"`
+}
+
+// NPF video block
+type VideoBlock struct {
+	Url string `json:"url,omitempty"`
+	Media *MediaObject `json:"media,omitempty"`
+	Provider string `json:"provider,omitempty"`
+	EmbedHtml string `json:"embed_html,omitempty"`
+	EmbedIframe *EmbedIframe `json:"embed_iframe,omitempty"`
+	EmbedUrl string `json:"embed_url,omitempty"`
+	Poster []MediaObject `json:"poster,omitempty"`
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	Attribution *Attribution `json:"attribution,omitempty"`
+	CanAutoplayOnCellular bool `json:"can_autoplay_on_cellular,omitempty"`
+}
+
+// Video block substructure
+type EmbedIframe struct {
+	Url string `json:"url"`
+	Width int `json:"width"`
+	Height int `json:"height"`
+}
+
+// NPF poll block
+type PollBlock struct {
+	ClientId string `json:"client_id"`
+	Question string `json:"question"`
+	Answers []PollAnswer `json:"answers"`
+	Settings PollSettings `json:"settings"`
+	CreatedAt string `json:"created_at,omitempty"`
+	Timestamp int64 `json:"timestamp,omitempty"`
+}
+
+// Poll block substructure
+type PollAnswer struct {
+	ClientId string `json:"client_id"`
+	AnswerText string `json:"answer_text"`
+}
+
+// Poll block substructure
+type PollSettings struct {
+	MultipleChoice bool `json:"multiple_choice"`
+	CloseStatus string `json:"close_status"`
+	// Seconds after creation the poll closes
+	ExpireAfter int64 `json:"expire_after"`
+	Source string `json:"source"`
+}
+
+// NPF paywall block
+type PaywallBlock struct {
+	// cta, divider or disabled
+	Subtype string `json:"subtype"`
+	Url string `json:"url,omitempty"`
+	Title string `json:"title,omitempty"`
+	Text string `json:"text,omitempty"`
+	Color string `json:"color,omitempty"`
+	IsVisible bool `json:"is_visible,omitempty"`
+}
+
+// Content block of a type this library does not model; the raw JSON is kept so it survives re-encoding
+type UnknownBlock struct {
+	BlockType string
+	Raw json.RawMessage
+}
+
+// NPF media object, used by image, audio, video and link blocks
+type MediaObject struct {
+	Url string `json:"url,omitempty"`
+	// Name of the multipart file part containing the media, only used when uploading
+	Identifier string `json:"identifier,omitempty"`
+	// MIME type of the media
+	Type string `json:"type,omitempty"`
+	Width int `json:"width,omitempty"`
+	Height int `json:"height,omitempty"`
+	OriginalDimensionsMissing bool `json:"original_dimensions_missing,omitempty"`
+	Cropped bool `json:"cropped,omitempty"`
+	HasOriginalDimensions bool `json:"has_original_dimensions,omitempty"`
+	Poster *MediaObject `json:"poster,omitempty"`
+}
+
+// Attribution of a content block to a post, link, blog or app
+type Attribution struct {
+	// post, link, blog or app
+	Type string `json:"type"`
+	Url string `json:"url,omitempty"`
+	Post *struct {
+		Id string `json:"id"`
+	} `json:"post,omitempty"`
+	Blog *BlogMention `json:"blog,omitempty"`
+	AppName string `json:"app_name,omitempty"`
+	DisplayText string `json:"display_text,omitempty"`
+	Logo *MediaObject `json:"logo,omitempty"`
+}
+
+// NPF layout block describing how content blocks are arranged
+type LayoutBlock struct {
+	// rows, ask or condensed
+	Type string `json:"type"`
+	// Only present for rows layouts
+	Display []LayoutRow `json:"display,omitempty"`
+	TruncateAfter *int `json:"truncate_after,omitempty"`
+	// Only present for ask and condensed layouts
+	Blocks []int `json:"blocks,omitempty"`
+	Attribution *Attribution `json:"attribution,omitempty"`
+}
+
+// Layout block substructure listing the content block indices shown in a row
+type LayoutRow struct {
+	Blocks []int `json:"blocks"`
+	Mode *struct {
+		Type string `json:"type"`
+	} `json:"mode,omitempty"`
+}
+
+// Text block type discriminator
+func (b *TextBlock) GetType() string {
+	return "text"
+}
+
+// Image block type discriminator
+func (b *ImageBlock) GetType() string {
+	return "image"
+}
+
+// Link block type discriminator
+func (b *LinkBlock) GetType() string {
+	return "link"
+}
+
+// Audio block type discriminator
+func (b *AudioBlock) GetType() string {
+	return "audio"
+}
+
+// Video block type discriminator
+func (b *VideoBlock) GetType() string {
+	return "video"
+}
+
+// Poll block type discriminator
+func (b *PollBlock) GetType() string {
+	return "poll"
+}
+
+// Paywall block type discriminator
+func (b *PaywallBlock) GetType() string {
+	return "paywall"
+}
+
+// Type the unknown block was received with
+func (b *UnknownBlock) GetType() string {
+	return b.BlockType
+}
+
+// Utility function to create the proper instance of ContentBlock for the given type
+func makeContentBlockFromType(t string) (ContentBlock, error) {
+	switch t {
+	case "text":
+		return &TextBlock{}, nil
+	case "image":
+		return &ImageBlock{}, nil
+	case "link":
+		return &LinkBlock{}, nil
+	case "audio":
+		return &AudioBlock{}, nil
+	case "video":
+		return &VideoBlock{}, nil
+	case "poll":
+		return &PollBlock{}, nil
+	case "paywall":
+		return &PaywallBlock{}, nil
+	}
+	return &UnknownBlock{BlockType: t}, errors.New(fmt.Sprintf("Unknown content block type %s", t))
+}
+
+// List of content blocks which encodes and decodes each block along with its `type` discriminator
+type ContentBlocks []ContentBlock
+
+// Decodes each block into the struct matching its type; unknown types are kept as *UnknownBlock
+func (c *ContentBlocks) UnmarshalJSON(data []byte) error {
+	raw := []json.RawMessage{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	blocks := make(ContentBlocks, 0, len(raw))
+	for _, r := range raw {
+		typed := struct {
+			Type string `json:"type"`
+		}{}
+		if err := json.Unmarshal(r, &typed); err != nil {
+			return err
+		}
+		block, err := makeContentBlockFromType(typed.Type)
+		if err != nil {
+			block.(*UnknownBlock).Raw = r
+		} else if err = json.Unmarshal(r, block); err != nil {
+			return err
+		}
+		blocks = append(blocks, block)
+	}
+	*c = blocks
+	return nil
+}
+
+// Encodes each block with its `type` discriminator added
+func (c ContentBlocks) MarshalJSON() ([]byte, error) {
+	if c == nil {
+		return []byte("null"), nil
+	}
+	encoded := make([]json.RawMessage, 0, len(c))
+	for _, block := range c {
+		b, err := marshalContentBlock(block)
+		if err != nil {
+			return nil, err
+		}
+		encoded = append(encoded, b)
+	}
+	return json.Marshal(encoded)
+}
+
+// Encodes a single block, adding its `type` discriminator
+func marshalContentBlock(block ContentBlock) ([]byte, error) {
+	if unknown, ok := block.(*UnknownBlock); ok {
+		return unknown.Raw, nil
+	}
+	b, err := json.Marshal(block)
+	if err != nil {
+		return nil, err
+	}
+	fields := map[string]json.RawMessage{}
+	if err = json.Unmarshal(b, &fields); err != nil {
+		return nil, err
+	}
+	if fields["type"], err = json.Marshal(block.GetType()); err != nil {
+		return nil, err
+	}
+	return json.Marshal(fields)
+}
+
+// Trail items contain an HTML string as `content` in the legacy format and a list of blocks in NPF,
+// so the content is decoded into Content or NPFContent accordingly
+func (t *ReblogTrailItem) UnmarshalJSON(data []byte) error {
+	type trailItem ReblogTrailItem
+	item := struct {
+		*trailItem
+		Content json.RawMessage `json:"content"`
+	}{trailItem: (*trailItem)(t)}
+	if err := json.Unmarshal(data, &item); err != nil {
+		return err
+	}
+	content := bytes.TrimSpace(item.Content)
+	if len(content) < 1 {
+		return nil
+	}
+	switch content[0] {
+	case '[':
+		return json.Unmarshal(content, &t.NPFContent)
+	case '"':
+		return json.Unmarshal(content, &t.Content)
+	}
+	return nil
+}
+
+// Encodes NPFContent as `content` when present, so either format survives re-encoding
+func (t ReblogTrailItem) MarshalJSON() ([]byte, error) {
+	type trailItem ReblogTrailItem
+	if t.NPFContent == nil {
+		return json.Marshal(trailItem(t))
+	}
+	return json.Marshal(struct {
+		trailItem
+		Content ContentBlocks `json:"content"`
+	}{trailItem(t), t.NPFContent})
+}
@@ -0,0 +1,121 @@
+package tumblr
+
+import (
+	"testing"
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+const npfPostJson = `{
+	"id": 1986,
+	"type": "blocks",
+	"blog_name": "david",
+	"content": [
+		{"type": "text", "text": "Hello world", "subtype": "heading1", "formatting": [{"start": 0, "end": 5, "type": "link", "url": "https://tumblr.com"}]},
+		{"type": "image", "media": [{"url": "https://64.media.tumblr.com/a.jpg", "type": "image/jpeg", "width": 540, "height": 405}], "alt_text": "A cat"},
+		{"type": "link", "url": "https://tumblr.com", "title": "Tumblr"},
+		{"type": "audio", "provider": "soundcloud", "title": "Song", "artist": "Band"},
+		{"type": "video", "provider": "youtube", "embed_iframe": {"url": "https://youtube.com/embed/x", "width": 540, "height": 300}},
+		{"type": "poll", "client_id": "abc", "question": "Cats?", "answers": [{"client_id": "1", "answer_text": "Yes"}], "settings": {"multiple_choice": false, "close_status": "closed-after", "expire_after": 604800, "source": "tumblr"}},
+		{"type": "paywall", "subtype": "cta", "title": "Subscribe"},
+		{"type": "hologram", "depth": 3}
+	],
+	"layout": [{"type": "rows", "display": [{"blocks": [0]}, {"blocks": [1, 2]}], "truncate_after": 1}],
+	"trail": [{"post": {"id": "1234"}, "blog": {"name": "other"}, "content": [{"type": "text", "text": "Original"}], "layout": []}]
+}`
+
+func TestContentBlocksUnmarshal(t *testing.T) {
+	post := Post{}
+	if err := json.Unmarshal([]byte(npfPostJson), &post); err != nil {
+		t.Fatal("Failed to decode NPF post", err)
+	}
+	expectedTypes := []string{"*tumblr.TextBlock", "*tumblr.ImageBlock", "*tumblr.LinkBlock", "*tumblr.AudioBlock", "*tumblr.VideoBlock", "*tumblr.PollBlock", "*tumblr.PaywallBlock", "*tumblr.UnknownBlock"}
+	if len(post.Content) != len(expectedTypes) {
+		t.Fatalf("Expected %d content blocks, got %d", len(expectedTypes), len(post.Content))
+	}
+	for i, expected := range expectedTypes {
+		if actual := reflect.TypeOf(post.Content[i]).String(); actual != expected {
+			t.Errorf("Expected block %d to decode as %s, got %s", i, expected, actual)
+		}
+	}
+	text := post.Content[0].(*TextBlock)
+	if text.Text != "Hello world" || text.Subtype != "heading1" || len(text.Formatting) != 1 || text.Formatting[0].Url != "https://tumblr.com" {
+		t.Fatal("Text block not decoded correctly", text)
+	}
+	if image := post.Content[1].(*ImageBlock); len(image.Media) != 1 || image.Media[0].Width != 540 || image.AltText != "A cat" {
+		t.Fatal("Image block not decoded correctly", image)
+	}
+	if poll := post.Content[5].(*PollBlock); len(poll.Answers) != 1 || poll.Settings.ExpireAfter != 604800 {
+		t.Fatal("Poll block not decoded correctly", poll)
+	}
+	if unknown := post.Content[7]; unknown.GetType() != "hologram" {
+		t.Fatal("Unknown block should keep its type")
+	}
+	if len(post.Layout) != 1 || post.Layout[0].Type != "rows" || len(post.Layout[0].Display) != 2 || *post.Layout[0].TruncateAfter != 1 {
+		t.Fatal("Layout not decoded correctly", post.Layout)
+	}
+	if len(post.Trail) != 1 || len(post.Trail[0].NPFContent) != 1 || post.Trail[0].Content != "" {
+		t.Fatal("NPF trail content should be decoded into blocks", post.Trail)
+	}
+}
+
+func TestContentBlocksRoundTrip(t *testing.T) {
+	post := Post{}
+	if err := json.Unmarshal([]byte(npfPostJson), &post); err != nil {
+		t.Fatal("Failed to decode NPF post", err)
+	}
+	encoded, err := json.Marshal(post)
+	if err != nil {
+		t.Fatal("Failed to encode NPF post", err)
+	}
+	decoded := Post{}
+	if err = json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatal("Failed to decode re-encoded post", err)
+	}
+	if !reflect.DeepEqual(post.Content[:7], decoded.Content[:7]) || !reflect.DeepEqual(post.Trail[0].NPFContent, decoded.Trail[0].NPFContent) {
+		t.Fatal("Content blocks should survive re-encoding")
+	}
+	if !strings.Contains(string(encoded), `"depth":3`) {
+		t.Fatal("Unknown blocks should be re-encoded as they were received")
+	}
+}
+
+func TestContentBlocksMarshalAddsType(t *testing.T) {
+	blocks := ContentBlocks{&TextBlock{Text: "hi"}, &ImageBlock{Media: []MediaObject{MediaObject{Url: "https://example.com/a.png"}}}}
+	encoded, err := json.Marshal(blocks)
+	if err != nil {
+		t.Fatal("Failed to encode blocks", err)
+	}
+	expected := `[{"text":"hi","type":"text"},{"media":[{"url":"https://example.com/a.png"}],"type":"image"}]`
+	if string(encoded) != expected {
+		t.Fatalf("Expected %s, got %s", expected, encoded)
+	}
+}
+
+func TestLegacyTrailContent(t *testing.T) {
+	item := ReblogTrailItem{}
+	if err := json.Unmarshal([]byte(`{"content": "<p>hi</p>", "content_raw": "<p>hi</p>", "post": {"id": 1}}`), &item); err != nil {
+		t.Fatal("Failed to decode legacy trail item", err)
+	}
+	if item.Content != "<p>hi</p>" || item.NPFContent != nil {
+		t.Fatal("Legacy trail content should be decoded as a string")
+	}
+	encoded, _ := json.Marshal(item)
+	decoded := ReblogTrailItem{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil || decoded.Content != item.Content {
+		t.Fatal("Legacy trail content should be re-encoded as a string", string(encoded))
+	}
+}
+
+func TestMakeContentBlockFromType(t *testing.T) {
+	for _, blockType := range []string{"text", "image", "link", "audio", "video", "poll", "paywall"} {
+		block, err := makeContentBlockFromType(blockType)
+		if err != nil || block.GetType() != blockType {
+			t.Errorf("Failed to make block of type %s", blockType)
+		}
+	}
+	if _, err := makeContentBlockFromType("unknown"); err == nil {
+		t.Fatal("Unknown type should generate an error")
+	}
+}
@@ -1,39 +1,84 @@
 package tumblr
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"reflect"
+	"net/http"
 	"net/url"
+	"strconv"
+	"strings"
+	"time"
 )
 
 // Representation of a list of Posts
 type Posts struct {
 	client ClientInterface
-	response Response
-	parsedPosts []PostInterface
+	path string
+	name string
+	params url.Values
+	byOffset bool
+	byBeforeId bool
+	// The undecoded posts, kept so that All() can report a malformed post without failing the whole page
+	rawPosts json.RawMessage
 	Posts []MiniPost `json:"posts"`
+	// The same posts decoded into their concrete types (QuotePost, PhotoPost, etc) once All() has been called
+	FullPosts PostList `json:"-"`
 	TotalPosts int64 `json:"total_posts"`
 }
 
-// Method to retrieve fully fleshed post data from stubs and cache result
+// Decodes the posts as stubs, keeping them undecoded for All()
+func (p *Posts) UnmarshalJSON(data []byte) error {
+	type posts Posts
+	if err := json.Unmarshal(data, (*posts)(p)); err != nil {
+		return err
+	}
+	raw := struct {
+		Posts json.RawMessage `json:"posts"`
+	}{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	p.rawPosts = raw.Posts
+	p.FullPosts = nil
+	return nil
+}
+
+// Encodes the full posts in place of the stubs, so they survive re-encoding
+func (p Posts) MarshalJSON() ([]byte, error) {
+	type posts Posts
+	if p.FullPosts != nil {
+		return json.Marshal(struct {
+			posts
+			Posts PostList `json:"posts"`
+		}{posts(p), p.FullPosts})
+	}
+	if p.rawPosts != nil {
+		return json.Marshal(struct {
+			posts
+			Posts json.RawMessage `json:"posts"`
+		}{posts(p), p.rawPosts})
+	}
+	return json.Marshal(posts(p))
+}
+
+// Method to retrieve fully fleshed post data, decoding it on first use; for a list built by hand, they are created from the stubs
 func (p *Posts) All() ([]PostInterface, error) {
-	var err error = nil
-	if p.parsedPosts == nil {
-		r := struct {
-			Response struct {
-					 Posts []PostInterface `json:"posts"`
-				 } `json:"response"`
-		}{}
-		r.Response.Posts = makePostsFromMinis(p.Posts, p.client)
-		if err = json.Unmarshal(p.response.body, &r); err != nil {
-			p.parsedPosts = []PostInterface{}
-		} else {
-			p.parsedPosts = r.Response.Posts
-		}
+	if p.FullPosts != nil {
+		return p.FullPosts, nil
+	}
+	if p.rawPosts == nil {
+		p.FullPosts = makePostsFromMinis(p.Posts, p.client)
+		return p.FullPosts, nil
 	}
-	return p.parsedPosts, err
+	full, err := decodePostList(p.rawPosts, p.client)
+	if err != nil {
+		return nil, err
+	}
+	p.FullPosts = full
+	return p.FullPosts, nil
 }
 
 // Method to retrieve a single Post entity at a given index; returns nil if index is out of bounds
@@ -53,6 +98,8 @@ type MiniPost struct {
 	Type string `json:"type"`
 	BlogName string `json:"blog_name"`
 	ReblogKey string `json:"reblog_key"`
+	// Only present when listing liked posts
+	LikedTimestamp uint64 `json:"liked_timestamp,omitempty"`
 }
 
 // Starting point for performing operations on a post
@@ -96,6 +143,9 @@ type Post struct {
 	FeaturedTimestamp uint64 `json:"featured_timestamp,omitempty"`
 	TrackName string `json:"track_name,omitempty"`
 	Trail []ReblogTrailItem `json:"trail"`
+	// NPF content and layout, present when posts are requested with npf=true
+	Content ContentBlocks `json:"content,omitempty"`
+	Layout []LayoutBlock `json:"layout,omitempty"`
 }
 
 // Post substructure
@@ -108,6 +158,10 @@ type ReblogTrailItem struct {
 		     // sometimes an actual int, sometimes a numeric string, always a headache
 		     Id interface{} `json:"id"`
 	     } `json:"post"`
+	// Trail item's content blocks, set instead of Content for NPF posts
+	NPFContent ContentBlocks `json:"-"`
+	Layout []LayoutBlock `json:"layout,omitempty"`
+	BrokenBlogName string `json:"broken_blog_name,omitempty"`
 }
 
 // PostInterface for use in typed structures which could contain any of the below subtypes
@@ -232,8 +286,8 @@ func (p *Post) GetSelf() (*Post) {
 }
 
 // helper method for querying a given path which should return a list of posts
-func queryPosts(client ClientInterface, path, name string, params url.Values) (*Posts, error) {
-	response, err := client.GetWithParams(blogPath(path, name), params)
+func queryPosts(ctx context.Context, client ClientInterface, path, name string, params url.Values) (*Posts, error) {
+	response, err := doRequest(ctx, client, http.MethodGet, blogPath(path, name), params)
 	if err != nil {
 		return nil, err
 	}
@@ -241,40 +295,243 @@ func queryPosts(client ClientInterface, path, name string, params url.Values) (*
 		Response Posts `json:"response"`
 	}{}
 	if err = json.Unmarshal(response.body, &posts); err == nil {
-		posts.Response.response = response
 		posts.Response.client = client
-		// store
+		// store what's needed to request neighbouring pages
+		posts.Response.path = path
+		posts.Response.name = name
+		posts.Response.params = copyParams(params)
+		posts.Response.byOffset = params.Get("offset") != ""
+		posts.Response.byBeforeId = params.Get("before_id") != ""
 		return &posts.Response, nil
 	}
 	return nil, err
 }
 
+// Retrieves the next page of posts, by before_id if the current page was requested that way and by offset otherwise
+func (p *Posts) Next() (*Posts, error) {
+	return p.NextContext(context.Background())
+}
+
+// Retrieves the next page of posts, aborting if ctx is done
+func (p *Posts) NextContext(ctx context.Context) (*Posts, error) {
+	if p.byBeforeId {
+		return p.NextByBeforeIdContext(ctx)
+	}
+	return p.NextByOffsetContext(ctx)
+}
+
+// Retrieves the next page of posts using the current page's offset
+func (p *Posts) NextByOffset() (*Posts, error) {
+	return p.NextByOffsetContext(context.Background())
+}
+
+// Retrieves the next page of posts using the current page's offset, aborting if ctx is done
+func (p *Posts) NextByOffsetContext(ctx context.Context) (*Posts, error) {
+	if p.byBeforeId {
+		return nil, MixedPaginationMethodsError
+	}
+	if p.isLastPage() {
+		return nil, NoNextPageError
+	}
+	offset := p.offset() + len(p.Posts)
+	if p.TotalPosts > 0 && int64(offset) >= p.TotalPosts {
+		return nil, NoNextPageError
+	}
+	params := copyParams(p.params)
+	params.Set("offset", strconv.Itoa(offset))
+	return queryPosts(ctx, p.client, p.path, p.name, params)
+}
+
+// Retrieves the next page of posts using the current page's last Post id
+func (p *Posts) NextByBeforeId() (*Posts, error) {
+	return p.NextByBeforeIdContext(context.Background())
+}
+
+// Retrieves the next page of posts using the current page's last Post id, aborting if ctx is done
+func (p *Posts) NextByBeforeIdContext(ctx context.Context) (*Posts, error) {
+	if p.byOffset {
+		return nil, MixedPaginationMethodsError
+	}
+	if p.isLastPage() {
+		return nil, NoNextPageError
+	}
+	params := setParamsUint(p.Posts[len(p.Posts) - 1].Id, copyParams(p.params), "before_id")
+	return queryPosts(ctx, p.client, p.path, p.name, params)
+}
+
+// Retrieves the previous page of posts; only possible when paginating by offset
+func (p *Posts) Prev() (*Posts, error) {
+	return p.PrevContext(context.Background())
+}
+
+// Retrieves the previous page of posts, aborting if ctx is done
+func (p *Posts) PrevContext(ctx context.Context) (*Posts, error) {
+	offset := p.offset()
+	if p.byBeforeId || offset <= 0 {
+		return nil, NoPrevPageError
+	}
+	limit := p.limit()
+	if limit < 1 {
+		limit = len(p.Posts)
+	}
+	offset -= limit
+	if offset < 0 {
+		offset = 0
+	}
+	params := copyParams(p.params)
+	params.Set("offset", strconv.Itoa(offset))
+	return queryPosts(ctx, p.client, p.path, p.name, params)
+}
+
+// Current page's offset, 0 if unspecified
+func (p *Posts) offset() int {
+	offset, err := strconv.Atoi(p.params.Get("offset"))
+	if err != nil {
+		return 0
+	}
+	return offset
+}
+
+// Most posts the API returns per page, whatever `limit` is requested
+const maxPostsLimit = 20
+
+// Page size the API will use for the requested `limit`, 0 if unspecified
+func (p *Posts) limit() int {
+	limit, err := strconv.Atoi(p.params.Get("limit"))
+	if err != nil || limit < 1 {
+		return 0
+	}
+	if limit > maxPostsLimit {
+		return maxPostsLimit
+	}
+	return limit
+}
+
+// Whether the current page is known to be the last: it is empty or smaller than the page size the API honoured
+func (p *Posts) isLastPage() bool {
+	if len(p.Posts) < 1 {
+		return true
+	}
+	return len(p.Posts) < p.limit()
+}
+
 // Retrieve a blog's posts, in the API docs you can find how to filter by ID, type, etc
 func GetPosts(client ClientInterface, name string, params url.Values) (*Posts, error) {
-	return queryPosts(client, "/blog/%s/posts", name, params)
+	return GetPostsContext(context.Background(), client, name, params)
+}
+
+// Retrieve a blog's posts, aborting if ctx is done
+func GetPostsContext(ctx context.Context, client ClientInterface, name string, params url.Values) (*Posts, error) {
+	return queryPosts(ctx, client, "/blog/%s/posts", name, params)
 }
 
 // Retrieve a blog's Queue
 func GetQueue(client ClientInterface, name string, params url.Values) (*Posts, error) {
-	return queryPosts(client, "/blog/%s/posts/queue", name, params)
+	return GetQueueContext(context.Background(), client, name, params)
 }
 
-// Retrieve a blog's drafts
+// Retrieve a blog's Queue, aborting if ctx is done
+func GetQueueContext(ctx context.Context, client ClientInterface, name string, params url.Values) (*Posts, error) {
+	return queryPosts(ctx, client, "/blog/%s/posts/queue", name, params)
+}
+
+// Retrieve a blog's drafts; Next() pages through them by before_id, as the drafts endpoint has no offset
 func GetDrafts(client ClientInterface, name string, params url.Values) (*Posts, error) {
-	return queryPosts(client, "/blog/%s/posts/draft", name, params)
+	return GetDraftsContext(context.Background(), client, name, params)
+}
+
+// Retrieve a blog's drafts, aborting if ctx is done
+func GetDraftsContext(ctx context.Context, client ClientInterface, name string, params url.Values) (*Posts, error) {
+	posts, err := queryPosts(ctx, client, "/blog/%s/posts/draft", name, params)
+	if err != nil {
+		return nil, err
+	}
+	posts.byBeforeId = true
+	posts.byOffset = false
+	return posts, nil
 }
 
 // Retrieve a blog's submsisions
 func GetSubmissions(client ClientInterface, name string, params url.Values) (*Posts, error) {
-	return queryPosts(client, "/blog/%s/posts/submission", name, params)
+	return GetSubmissionsContext(context.Background(), client, name, params)
+}
+
+// Retrieve a blog's submissions, aborting if ctx is done
+func GetSubmissionsContext(ctx context.Context, client ClientInterface, name string, params url.Values) (*Posts, error) {
+	return queryPosts(ctx, client, "/blog/%s/posts/submission", name, params)
+}
+
+// Retrieve a single post by ID, decoded into the struct matching its type
+func GetPost(client ClientInterface, name string, postId uint64) (PostInterface, error) {
+	return GetPostContext(context.Background(), client, name, postId)
+}
+
+// Retrieve a single post by ID, aborting if ctx is done
+func GetPostContext(ctx context.Context, client ClientInterface, name string, postId uint64) (PostInterface, error) {
+	posts, err := queryPosts(ctx, client, "/blog/%s/posts", name, setPostId(postId, url.Values{}))
+	if err != nil {
+		return nil, err
+	}
+	all, err := posts.All()
+	if err != nil {
+		return nil, err
+	}
+	if len(all) < 1 {
+		return nil, &APIError{StatusCode: http.StatusNotFound, Message: "Post not found"}
+	}
+	return all[0], nil
+}
+
+// Retrieve a single post by ID from the /posts/{id} endpoint, which responds in NPF unless params
+// set `post_format` to legacy; NPF posts are decoded as *Post with their blocks in Content
+func GetNPFPost(client ClientInterface, name string, postId uint64, params url.Values) (PostInterface, error) {
+	return GetNPFPostContext(context.Background(), client, name, postId, params)
+}
+
+// Retrieve a single post by ID from the /posts/{id} endpoint, aborting if ctx is done
+func GetNPFPostContext(ctx context.Context, client ClientInterface, name string, postId uint64, params url.Values) (PostInterface, error) {
+	path := blogPath("/blog/%s/posts/" + strconv.FormatUint(postId, 10), name)
+	response, err := doRequest(ctx, client, http.MethodGet, path, params)
+	if err != nil {
+		return nil, err
+	}
+	result := struct {
+		Response json.RawMessage `json:"response"`
+	}{}
+	if err = json.Unmarshal(response.body, &result); err != nil {
+		return nil, err
+	}
+	if len(result.Response) < 1 {
+		return nil, &APIError{StatusCode: http.StatusNotFound, Message: "Post not found"}
+	}
+	post, err := decodePost(result.Response)
+	if err != nil {
+		return nil, err
+	}
+	post.GetSelf().client = client
+	return post, nil
+}
+
+// Retrieves the full post the PostRef refers to. The API can only look a post up within its blog,
+// so BlogName must be set; a ref from NewPostRefById fails with NoBlogNameError until it is
+func (p *PostRef) Fetch() (PostInterface, error) {
+	return p.FetchContext(context.Background())
+}
+
+// Retrieves the full post the PostRef refers to, aborting if ctx is done
+func (p *PostRef) FetchContext(ctx context.Context) (PostInterface, error) {
+	if p.BlogName == "" {
+		return nil, NoBlogNameError
+	}
+	return GetPostContext(ctx, p.client, p.BlogName, p.Id)
 }
 
 // Util method for decoding the response and converting the resulting ID into a PostRef
-func doPost(client ClientInterface, path, blogName string, params url.Values) (*PostRef, error) {
+func doPost(ctx context.Context, client ClientInterface, path, blogName string, params url.Values) (*PostRef, error) {
 	if blogName == "" {
-		return nil, errors.New("No blog name provided")
+		return nil, NoBlogNameError
 	}
-	response, err := client.PostWithParams(blogPath(path, blogName), params)
+	response, err := doRequest(ctx, client, http.MethodPost, blogPath(path, blogName), params)
 	if err != nil {
 		return nil, err
 	}
@@ -291,7 +548,8 @@ func doPost(client ClientInterface, path, blogName string, params url.Values) (*
 	return nil, err
 }
 
-// Creates a PostRef with the given properties set
+// Creates a PostRef with the given properties set. BlogName is left empty, so set it before calling methods
+// which address the post through its blog, such as Fetch, Edit or Notes; the API has no lookup by ID alone
 func NewPostRefById(client ClientInterface, id uint64) (*PostRef) {
 	return &PostRef{
 		client: client,
@@ -316,43 +574,329 @@ func (r *PostRef)SetClient(c ClientInterface) {
 
 // Create a post, return the ID on success, error on failure
 func CreatePost(client ClientInterface, name string, params url.Values) (*PostRef, error) {
-	return doPost(client, "/blog/%s/post", name, params)
+	return CreatePostContext(context.Background(), client, name, params)
+}
+
+// Create a post, aborting if ctx is done
+func CreatePostContext(ctx context.Context, client ClientInterface, name string, params url.Values) (*PostRef, error) {
+	return doPost(ctx, client, "/blog/%s/post", name, params)
 }
 
 // Edit a given post, returns nil if successful, error on failure
 func EditPost(client ClientInterface, blogName string, postId uint64, params url.Values) error {
-	_, err := client.PostWithParams(blogPath("/blog/%s/post/edit", blogName), setPostId(postId, params))
+	return EditPostContext(context.Background(), client, blogName, postId, params)
+}
+
+// Edit a given post, aborting if ctx is done
+func EditPostContext(ctx context.Context, client ClientInterface, blogName string, postId uint64, params url.Values) error {
+	_, err := doRequest(ctx, client, http.MethodPost, blogPath("/blog/%s/post/edit", blogName), setPostId(postId, params))
 	return err
 }
 
 // Convenience method to allow calling post.Edit(params)
 func (p *PostRef) Edit(params url.Values) error {
-	return EditPost(p.client, p.BlogName, p.Id, params)
+	return p.EditContext(context.Background(), params)
+}
+
+// Convenience method to allow calling post.EditContext(ctx, params)
+func (p *PostRef) EditContext(ctx context.Context, params url.Values) error {
+	return EditPostContext(ctx, p.client, p.BlogName, p.Id, params)
+}
+
+// Post state accepted when creating or editing posts
+type PostState string
+
+const (
+	StatePublished PostState = "published"
+	StateQueue PostState = "queue"
+	StateDraft PostState = "draft"
+	StatePrivate PostState = "private"
+)
+
+// Post data for creating or editing a post in the Neue Post Format
+type NPFPostRequest struct {
+	Content ContentBlocks `json:"content"`
+	Layout []LayoutBlock `json:"layout,omitempty"`
+	State PostState `json:"state,omitempty"`
+	// When to publish a queued post, zero value for the blog's queue schedule
+	PublishOn time.Time `json:"-"`
+	// Backdates the post, zero value for now
+	Date time.Time `json:"-"`
+	Tags []string `json:"-"`
+	SourceUrl string `json:"source_url,omitempty"`
+	SendToTwitter bool `json:"send_to_twitter,omitempty"`
+	IsPrivate bool `json:"is_private,omitempty"`
+	Slug string `json:"slug,omitempty"`
+	// everyone or noone
+	InteractabilityReblog string `json:"interactability_reblog,omitempty"`
+	// Only used when reblogging
+	ParentTumblelogUuid string `json:"parent_tumblelog_uuid,omitempty"`
+	ParentPostId string `json:"parent_post_id,omitempty"`
+	ReblogKey string `json:"reblog_key,omitempty"`
+	HideTrail bool `json:"hide_trail,omitempty"`
+}
+
+// Encodes tags as the comma separated list and times as the ISO 8601 strings the API expects
+func (r NPFPostRequest) MarshalJSON() ([]byte, error) {
+	type request NPFPostRequest
+	out := struct {
+		request
+		Tags string `json:"tags,omitempty"`
+		PublishOn string `json:"publish_on,omitempty"`
+		Date string `json:"date,omitempty"`
+	}{request: request(r), Tags: strings.Join(r.Tags, ",")}
+	if !r.PublishOn.IsZero() {
+		out.PublishOn = r.PublishOn.UTC().Format(time.RFC3339)
+	}
+	if !r.Date.IsZero() {
+		out.Date = r.Date.UTC().Format(time.RFC3339)
+	}
+	return json.Marshal(out)
+}
+
+// Create a post from NPF content, return the ID on success, error on failure
+func CreateNPFPost(client ClientInterface, name string, post NPFPostRequest) (*PostRef, error) {
+	return CreateNPFPostContext(context.Background(), client, name, post)
+}
+
+// Create a post from NPF content, aborting if ctx is done
+func CreateNPFPostContext(ctx context.Context, client ClientInterface, name string, post NPFPostRequest) (*PostRef, error) {
+	return doNPFPost(ctx, client, http.MethodPost, "/blog/%s/posts", name, post)
+}
+
+// Replace a given post's content with NPF content, return the post on success, error on failure
+func EditNPFPost(client ClientInterface, blogName string, postId uint64, post NPFPostRequest) (*PostRef, error) {
+	return EditNPFPostContext(context.Background(), client, blogName, postId, post)
+}
+
+// Replace a given post's content with NPF content, aborting if ctx is done
+func EditNPFPostContext(ctx context.Context, client ClientInterface, blogName string, postId uint64, post NPFPostRequest) (*PostRef, error) {
+	return doNPFPost(ctx, client, http.MethodPut, "/blog/%s/posts/" + strconv.FormatUint(postId, 10), blogName, post)
+}
+
+// Convenience method to allow calling post.EditNPF(post)
+func (p *PostRef) EditNPF(post NPFPostRequest) (*PostRef, error) {
+	return p.EditNPFContext(context.Background(), post)
+}
+
+// Convenience method to allow calling post.EditNPFContext(ctx, post)
+func (p *PostRef) EditNPFContext(ctx context.Context, post NPFPostRequest) (*PostRef, error) {
+	return EditNPFPostContext(ctx, p.client, p.BlogName, p.Id, post)
+}
+
+// Create a legacy post with files attached, sent as data for a single file or data[0], data[1], etc for several; return the ID on success, error on failure
+func CreatePostWithMedia(client ClientInterface, name string, params url.Values, files ...*MediaFile) (*PostRef, error) {
+	return CreatePostWithMediaContext(context.Background(), client, name, params, files...)
+}
+
+// Create a legacy post with files attached, aborting if ctx is done
+func CreatePostWithMediaContext(ctx context.Context, client ClientInterface, name string, params url.Values, files ...*MediaFile) (*PostRef, error) {
+	body, err := newLegacyPostBody(params, files)
+	if err != nil {
+		return nil, err
+	}
+	return sendPostBody(ctx, client, http.MethodPost, "/blog/%s/post", name, body)
+}
+
+// Builds the multipart body of a legacy post from its params and files
+func newLegacyPostBody(params url.Values, files []*MediaFile) (*MultipartBody, error) {
+	body := NewMultipartBody()
+	body.AddParams(params)
+	for i, file := range files {
+		if file == nil {
+			return nil, NilMediaFileError
+		}
+		// A single file is sent as plain `data`, which audio and video posts require
+		if len(files) == 1 {
+			body.AddFile("data", file)
+		} else {
+			body.AddFile(fmt.Sprintf("data[%d]", i), file)
+		}
+	}
+	return body, nil
+}
+
+// Create a post from NPF content with files attached; each file is referenced from the content by
+// a media object with the same identifier, see MediaFile.MediaObject()
+func CreateNPFPostWithMedia(client ClientInterface, name string, post NPFPostRequest, files ...*MediaFile) (*PostRef, error) {
+	return CreateNPFPostWithMediaContext(context.Background(), client, name, post, files...)
+}
+
+// Create a post from NPF content with files attached, aborting if ctx is done
+func CreateNPFPostWithMediaContext(ctx context.Context, client ClientInterface, name string, post NPFPostRequest, files ...*MediaFile) (*PostRef, error) {
+	return doNPFPostWithMedia(ctx, client, http.MethodPost, "/blog/%s/posts", name, post, files)
+}
+
+// Replace a given post's content with NPF content with files attached
+func EditNPFPostWithMedia(client ClientInterface, blogName string, postId uint64, post NPFPostRequest, files ...*MediaFile) (*PostRef, error) {
+	return EditNPFPostWithMediaContext(context.Background(), client, blogName, postId, post, files...)
+}
+
+// Replace a given post's content with NPF content with files attached, aborting if ctx is done
+func EditNPFPostWithMediaContext(ctx context.Context, client ClientInterface, blogName string, postId uint64, post NPFPostRequest, files ...*MediaFile) (*PostRef, error) {
+	return doNPFPostWithMedia(ctx, client, http.MethodPut, "/blog/%s/posts/" + strconv.FormatUint(postId, 10), blogName, post, files)
+}
+
+// Util method for sending the post as the `json` part of a multipart body, followed by a part for each file named by its identifier
+func doNPFPostWithMedia(ctx context.Context, client ClientInterface, method, path, blogName string, post NPFPostRequest, files []*MediaFile) (*PostRef, error) {
+	body := NewMultipartBody()
+	if err := body.AddJSON("json", post); err != nil {
+		return nil, err
+	}
+	for _, file := range files {
+		if file == nil {
+			return nil, NilMediaFileError
+		}
+		if file.Identifier == "" {
+			return nil, errors.New("Media file has no identifier to reference it from the post's content")
+		}
+		body.AddFile(file.Identifier, file)
+	}
+	return sendPostBody(ctx, client, method, path, blogName, body)
+}
+
+// Util method for sending the post as a JSON body and converting the resulting ID into a PostRef
+func doNPFPost(ctx context.Context, client ClientInterface, method, path, blogName string, post NPFPostRequest) (*PostRef, error) {
+	body, err := NewJSONBody(post)
+	if err != nil {
+		return nil, err
+	}
+	return sendPostBody(ctx, client, method, path, blogName, body)
+}
+
+// Sends a post body, decoding the resulting ID into a PostRef
+func sendPostBody(ctx context.Context, client ClientInterface, method, path, blogName string, body RequestBody) (*PostRef, error) {
+	if blogName == "" {
+		return nil, NoBlogNameError
+	}
+	response, err := doBodyRequest(ctx, client, method, blogPath(path, blogName), body)
+	if err != nil {
+		return nil, err
+	}
+	post := struct {
+		Response struct{
+			Id flexibleId `json:"id"`
+		} `json:"response"`
+	}{}
+	if err = json.Unmarshal(response.body, &post); err != nil {
+		return nil, err
+	}
+	ref := NewPostRefById(client, uint64(post.Response.Id))
+	ref.BlogName = blogName
+	return ref, nil
+}
+
+// Post ID which may be encoded as either a number or a string; the NPF endpoints send strings
+type flexibleId uint64
+
+// Decodes the ID from either a JSON number or a quoted string
+func (i *flexibleId) UnmarshalJSON(data []byte) error {
+	raw := strings.Trim(string(data), `"`)
+	if raw == "" || raw == "null" {
+		*i = 0
+		return nil
+	}
+	id, err := strconv.ParseUint(raw, 10, 64)
+	if err != nil {
+		return err
+	}
+	*i = flexibleId(id)
+	return nil
+}
+
+// Publishes the post, such as a draft or queued post
+func (p *PostRef) Publish() error {
+	return p.PublishContext(context.Background())
+}
+
+// Publishes the post, aborting if ctx is done
+func (p *PostRef) PublishContext(ctx context.Context) error {
+	return p.setState(ctx, StatePublished)
+}
+
+// Adds the post to the queue, to be published at the given time or by the blog's queue schedule if at is zero
+func (p *PostRef) Queue(at time.Time) error {
+	return p.QueueContext(context.Background(), at)
+}
+
+// Adds the post to the queue, aborting if ctx is done
+func (p *PostRef) QueueContext(ctx context.Context, at time.Time) error {
+	if at.IsZero() {
+		return p.setState(ctx, StateQueue)
+	}
+	return EditPostContext(ctx, p.client, p.BlogName, p.Id, ScheduleParams(url.Values{}, at))
+}
+
+// Moves the post to the blog's drafts
+func (p *PostRef) SaveAsDraft() error {
+	return p.SaveAsDraftContext(context.Background())
+}
+
+// Moves the post to the blog's drafts, aborting if ctx is done
+func (p *PostRef) SaveAsDraftContext(ctx context.Context) error {
+	return p.setState(ctx, StateDraft)
+}
+
+// Makes the post visible only to the blog's owner
+func (p *PostRef) MakePrivate() error {
+	return p.MakePrivateContext(context.Background())
+}
+
+// Makes the post visible only to the blog's owner, aborting if ctx is done
+func (p *PostRef) MakePrivateContext(ctx context.Context) error {
+	return p.setState(ctx, StatePrivate)
+}
+
+// Edits the post's state
+func (p *PostRef) setState(ctx context.Context, state PostState) error {
+	return EditPostContext(ctx, p.client, p.BlogName, p.Id, url.Values{
+		"state": []string{string(state)},
+	})
 }
 
 // Reblog a given post to the given blog, returns the reblog's post id if successful, else the error
 func ReblogPost(client ClientInterface, blogName string, postId uint64, reblogKey string, params url.Values) (*PostRef, error) {
+	return ReblogPostContext(context.Background(), client, blogName, postId, reblogKey, params)
+}
+
+// Reblog a given post to the given blog, aborting if ctx is done
+func ReblogPostContext(ctx context.Context, client ClientInterface, blogName string, postId uint64, reblogKey string, params url.Values) (*PostRef, error) {
 	if reblogKey == "" {
 		return nil, errors.New("No reblog key provided")
 	}
 	params.Set("reblog_key", reblogKey)
-	return doPost(client, "/blog/%s/post/reblog", blogName, setPostId(postId, params))
+	return doPost(ctx, client, "/blog/%s/post/reblog", blogName, setPostId(postId, params))
 }
 
 // Convenience method to allow calling post.Reblog(params)
 func (p *PostRef) ReblogOnBlog(name string, params url.Values) (*PostRef, error) {
-	return ReblogPost(p.client, name, p.Id, p.ReblogKey, params)
+	return p.ReblogOnBlogContext(context.Background(), name, params)
+}
+
+// Convenience method to allow calling post.ReblogOnBlogContext(ctx, name, params)
+func (p *PostRef) ReblogOnBlogContext(ctx context.Context, name string, params url.Values) (*PostRef, error) {
+	return ReblogPostContext(ctx, p.client, name, p.Id, p.ReblogKey, params)
 }
 
 // Delete a given blog's post by ID, nil if successful, error on failure
 func DeletePost(client ClientInterface, name string, postId uint64) error {
-	_, err := client.PostWithParams(blogPath("/blog/%s/post/delete", name), setPostId(postId, url.Values{}))
+	return DeletePostContext(context.Background(), client, name, postId)
+}
+
+// Delete a given blog's post by ID, aborting if ctx is done
+func DeletePostContext(ctx context.Context, client ClientInterface, name string, postId uint64) error {
+	_, err := doRequest(ctx, client, http.MethodPost, blogPath("/blog/%s/post/delete", name), setPostId(postId, url.Values{}))
 	return err
 }
 
 // Convenience method to allow calling post.Delete()
 func (p *PostRef) Delete() error {
-	return DeletePost(p.client, p.BlogName, p.Id)
+	return p.DeleteContext(context.Background())
+}
+
+// Convenience method to allow calling post.DeleteContext(ctx)
+func (p *PostRef) DeleteContext(ctx context.Context) error {
+	return DeletePostContext(ctx, p.client, p.BlogName, p.Id)
 }
 
 // Utility function to create the proper instance of Post and return a reference to the generic interface
@@ -374,18 +918,31 @@ func makePostFromType(t string) (PostInterface, error) {
 		return &AudioPost{}, nil
 	case "video":
 		return &VideoPost{}, nil
+	case "blocks":
+		// NPF posts carry their content in Post.Content
+		return &Post{}, nil
 	}
 	return &Post{}, errors.New(fmt.Sprintf("Unknown type %s", t))
 }
 
 // Likes a Post on behalf of the current user
 func (p *PostRef) Like() error {
-	return LikePost(p.client, p.Id, p.ReblogKey)
+	return p.LikeContext(context.Background())
+}
+
+// Likes a Post on behalf of the current user, aborting if ctx is done
+func (p *PostRef) LikeContext(ctx context.Context) error {
+	return LikePostContext(ctx, p.client, p.Id, p.ReblogKey)
 }
 
 // Unlikes a Post on behalf of the current user
 func (p *PostRef) Unlike() error {
-	return UnlikePost(p.client, p.Id, p.ReblogKey)
+	return p.UnlikeContext(context.Background())
+}
+
+// Unlikes a Post on behalf of the current user, aborting if ctx is done
+func (p *PostRef) UnlikeContext(ctx context.Context) error {
+	return UnlikePostContext(ctx, p.client, p.Id, p.ReblogKey)
 }
 
 // Create an array of PostInterfaces based on the array of MiniPost objects provided
@@ -393,6 +950,7 @@ func makePostsFromMinis(minis []MiniPost, client ClientInterface) []PostInterfac
 	posts := []PostInterface{}
 	for _, mini := range minis {
 		post, _ := makePostFromType(mini.Type)
+		post.GetSelf().MiniPost = mini
 		post.GetSelf().client = client
 		posts = append(posts, post)
 	}
@@ -0,0 +1,116 @@
+package tumblr
+
+import (
+	"encoding/json"
+)
+
+// List of posts which keeps each post's concrete type (QuotePost, PhotoPost, etc) when encoded and decoded,
+// so API results can be cached and restored
+type PostList []PostInterface
+
+// Decodes each post into the struct matching its `type`; unknown types are decoded as a plain *Post
+func (l *PostList) UnmarshalJSON(data []byte) error {
+	raw := []json.RawMessage{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	posts := make(PostList, 0, len(raw))
+	for _, r := range raw {
+		post, err := decodePost(r)
+		if err != nil {
+			return err
+		}
+		posts = append(posts, post)
+	}
+	*l = posts
+	return nil
+}
+
+// Decodes a list of posts, setting their client
+func decodePostList(data []byte, client ClientInterface) (PostList, error) {
+	posts := PostList{}
+	if err := json.Unmarshal(data, &posts); err != nil {
+		return nil, err
+	}
+	posts.SetClient(client)
+	return posts, nil
+}
+
+// Decodes a single post into the struct matching its `type`
+func decodePost(data []byte) (PostInterface, error) {
+	mini := MiniPost{}
+	if err := json.Unmarshal(data, &mini); err != nil {
+		return nil, err
+	}
+	post, _ := makePostFromType(mini.Type)
+	if err := json.Unmarshal(data, post); err != nil {
+		return nil, err
+	}
+	return post, nil
+}
+
+// Encodes each post, adding its `type` discriminator if it isn't already set
+func (l PostList) MarshalJSON() ([]byte, error) {
+	if l == nil {
+		return []byte("null"), nil
+	}
+	encoded := make([]json.RawMessage, 0, len(l))
+	for _, post := range l {
+		b, err := marshalPost(post)
+		if err != nil {
+			return nil, err
+		}
+		encoded = append(encoded, b)
+	}
+	return json.Marshal(encoded)
+}
+
+// Sets the client of every post in the list, such as after decoding it from a cache
+func (l PostList) SetClient(client ClientInterface) {
+	for _, post := range l {
+		post.GetSelf().SetClient(client)
+	}
+}
+
+// Encodes a single post, filling in its `type` from its concrete type if needed
+func marshalPost(post PostInterface) ([]byte, error) {
+	b, err := json.Marshal(post)
+	if err != nil || post.GetSelf().Type != "" {
+		return b, err
+	}
+	postType := postTypeOf(post)
+	if postType == "" {
+		return b, nil
+	}
+	fields := map[string]json.RawMessage{}
+	if err = json.Unmarshal(b, &fields); err != nil {
+		return nil, err
+	}
+	if fields["type"], err = json.Marshal(postType); err != nil {
+		return nil, err
+	}
+	return json.Marshal(fields)
+}
+
+// Legacy type of the post's concrete struct, the inverse of makePostFromType
+func postTypeOf(post PostInterface) string {
+	switch post.(type) {
+	case *QuotePost:
+		return "quote"
+	case *ChatPost:
+		return "chat"
+	case *PhotoPost:
+		return "photo"
+	case *TextPost:
+		return "text"
+	case *LinkPost:
+		return "link"
+	case *AnswerPost:
+		return "answer"
+	case *AudioPost:
+		return "audio"
+	case *VideoPost:
+		return "video"
+	}
+	return ""
+}
@@ -0,0 +1,74 @@
+package tumblr
+
+import (
+	"testing"
+	"encoding/json"
+	"net/url"
+)
+
+func TestPostListRoundTrip(t *testing.T) {
+	quote := &QuotePost{Post: Post{PostRef: PostRef{MiniPost: MiniPost{Id: 1, Type: "quote"}}}, Text: "Hi", Source: "Me"}
+	photo := &PhotoPost{Post: Post{PostRef: PostRef{MiniPost: MiniPost{Id: 2, Type: "photo"}}}, Photos: []Photo{Photo{Caption: "Cat"}}}
+	// type left unset, so it must be inferred from the struct
+	link := &LinkPost{Post: Post{PostRef: PostRef{MiniPost: MiniPost{Id: 3}}}, Url: "https://tumblr.com"}
+	npf := &Post{PostRef: PostRef{MiniPost: MiniPost{Id: 4, Type: "blocks"}}, Content: ContentBlocks{&TextBlock{Text: "Hello"}}}
+	encoded, err := json.Marshal(PostList{quote, photo, link, npf})
+	if err != nil {
+		t.Fatal("Failed to encode posts", err)
+	}
+	decoded := PostList{}
+	if err = json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatal("Failed to decode posts", err)
+	}
+	if len(decoded) != 4 {
+		t.Fatal("Expected 4 posts", len(decoded))
+	}
+	if q, ok := decoded[0].(*QuotePost); !ok || q.Text != "Hi" || q.Source != "Me" {
+		t.Fatal("Quote post not restored", decoded[0])
+	}
+	if p, ok := decoded[1].(*PhotoPost); !ok || len(p.Photos) != 1 || p.Photos[0].Caption != "Cat" {
+		t.Fatal("Photo post not restored", decoded[1])
+	}
+	if l, ok := decoded[2].(*LinkPost); !ok || l.Url != "https://tumblr.com" || l.Type != "link" {
+		t.Fatal("Post type should be inferred from its struct", decoded[2])
+	}
+	if p := decoded[3].GetSelf(); p.Id != 4 || len(p.Content) != 1 {
+		t.Fatal("NPF post not restored", decoded[3])
+	}
+	client := newTestClient("{}", nil)
+	decoded.SetClient(client)
+	for _, post := range decoded {
+		if post.GetSelf().client != client {
+			t.Fatal("Client not set on every post")
+		}
+	}
+}
+
+func TestPostListUnknownType(t *testing.T) {
+	decoded := PostList{}
+	if err := json.Unmarshal([]byte(`[{"id": 1, "type": "hologram"}]`), &decoded); err != nil {
+		t.Fatal("Unknown types should not generate an error", err)
+	}
+	if _, ok := decoded[0].(*Post); !ok || decoded[0].GetSelf().Id != 1 {
+		t.Fatal("Unknown types should decode as a plain Post", decoded[0])
+	}
+	if err := json.Unmarshal([]byte(`{}`), &decoded); err == nil {
+		t.Fatal("Non-array JSON should generate an error")
+	}
+}
+
+func TestPostListFromDashboard(t *testing.T) {
+	client := newTestClient(getDashString(textPost(1, 300)), nil)
+	dash, err := GetDashboard(client, url.Values{})
+	if err != nil {
+		t.Fatal("Failed to get dashboard", err)
+	}
+	encoded, _ := json.Marshal(dash.Posts)
+	cached := PostList{}
+	if err = json.Unmarshal(encoded, &cached); err != nil {
+		t.Fatal("Failed to decode cached posts", err)
+	}
+	if _, ok := cached[0].(*TextPost); !ok {
+		t.Fatal("Cached dashboard posts should keep their type", cached[0])
+	}
+}
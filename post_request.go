@@ -0,0 +1,392 @@
+package tumblr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Builder of the params for creating, editing or reblogging a legacy post
+type PostRequest interface {
+	// Returns the params for the post, or an error if a required field is missing
+	Params() (url.Values, error)
+	// Returns only the params which have been set, without checking required fields, for editing part of a post
+	EditParams() url.Values
+	// Files to attach to the post, sent as data for a single file or data[0], data[1], etc for several
+	Media() []*MediaFile
+}
+
+// Fields shared by every post request; T is the embedding request type, returned by each setter for chaining
+type postRequest[T any] struct {
+	self *T
+	postType string
+	params url.Values
+	files []*MediaFile
+	// each group must have at least one of its params set, with files satisfying `data`
+	required [][]string
+}
+
+// Prepares the shared fields, must be called by each request's constructor
+func (r *postRequest[T]) init(self *T, postType string, required ...[]string) {
+	r.self = self
+	r.postType = postType
+	r.params = url.Values{}
+	r.required = required
+	if postType != "" {
+		r.params.Set("type", postType)
+	}
+}
+
+// Sets a param, returning the request for chaining
+func (r *postRequest[T]) set(key, value string) *T {
+	r.params.Set(key, value)
+	return r.self
+}
+
+// Sets the post's state
+func (r *postRequest[T]) State(state PostState) *T {
+	return r.set("state", string(state))
+}
+
+// Sets the post's tags
+func (r *postRequest[T]) Tags(tags ...string) *T {
+	return r.set("tags", strings.Join(tags, ","))
+}
+
+// Sets the text of the tweet sent for the post, or "off" to not send one
+func (r *postRequest[T]) Tweet(tweet string) *T {
+	return r.set("tweet", tweet)
+}
+
+// Backdates the post
+func (r *postRequest[T]) Date(date time.Time) *T {
+	return r.set("date", date.UTC().Format("2006-01-02 15:04:05 GMT"))
+}
+
+// Sets when a queued post is published
+func (r *postRequest[T]) PublishOn(at time.Time) *T {
+	return r.set("publish_on", at.UTC().Format(time.RFC3339))
+}
+
+// Sets the format of the post's text, html or markdown
+func (r *postRequest[T]) Format(format string) *T {
+	return r.set("format", format)
+}
+
+// Sets the short text summary at the end of the post's URL
+func (r *postRequest[T]) Slug(slug string) *T {
+	return r.set("slug", slug)
+}
+
+// Sets whether inline images should be uploaded to Tumblr
+func (r *postRequest[T]) NativeInlineImages(native bool) *T {
+	return r.set("native_inline_images", fmt.Sprint(native))
+}
+
+// Returns a copy of the params, or an error if a required field is missing
+func (r *postRequest[T]) Params() (url.Values, error) {
+	for _, group := range r.required {
+		satisfied := false
+		for _, key := range group {
+			if r.params.Get(key) != "" || key == "data" && len(r.files) > 0 {
+				satisfied = true
+			}
+		}
+		if !satisfied {
+			return nil, fmt.Errorf("%s posts require %s", r.postType, strings.Join(group, " or "))
+		}
+	}
+	return copyParams(r.params), nil
+}
+
+// Returns a copy of the params set so far; an edit leaves the fields it doesn't send unchanged, so none are required
+func (r *postRequest[T]) EditParams() url.Values {
+	return copyParams(r.params)
+}
+
+// Returns the files to upload with the post
+func (r *postRequest[T]) Media() []*MediaFile {
+	return r.files
+}
+
+// Builder for text posts
+type TextPostRequest struct {
+	postRequest[TextPostRequest]
+}
+
+// Creates a text post request; Body is required
+func NewTextPostRequest() *TextPostRequest {
+	r := &TextPostRequest{}
+	r.init(r, "text", []string{"body"})
+	return r
+}
+
+// Sets the post's title
+func (r *TextPostRequest) Title(title string) *TextPostRequest {
+	return r.set("title", title)
+}
+
+// Sets the post's body, formatted according to Format
+func (r *TextPostRequest) Body(body string) *TextPostRequest {
+	return r.set("body", body)
+}
+
+// Builder for photo posts
+type PhotoPostRequest struct {
+	postRequest[PhotoPostRequest]
+}
+
+// Creates a photo post request; either Source or Data is required
+func NewPhotoPostRequest() *PhotoPostRequest {
+	r := &PhotoPostRequest{}
+	r.init(r, "photo", []string{"source", "data"})
+	return r
+}
+
+// Sets the caption shown below the photos
+func (r *PhotoPostRequest) Caption(caption string) *PhotoPostRequest {
+	return r.set("caption", caption)
+}
+
+// Sets the URL the photo links to when clicked
+func (r *PhotoPostRequest) Link(link string) *PhotoPostRequest {
+	return r.set("link", link)
+}
+
+// Sets the URL of the photo
+func (r *PhotoPostRequest) Source(source string) *PhotoPostRequest {
+	return r.set("source", source)
+}
+
+// Sets the photos to upload
+func (r *PhotoPostRequest) Data(files ...*MediaFile) *PhotoPostRequest {
+	r.files = files
+	return r
+}
+
+// Builder for quote posts
+type QuotePostRequest struct {
+	postRequest[QuotePostRequest]
+}
+
+// Creates a quote post request; Quote is required
+func NewQuotePostRequest() *QuotePostRequest {
+	r := &QuotePostRequest{}
+	r.init(r, "quote", []string{"quote"})
+	return r
+}
+
+// Sets the quoted text
+func (r *QuotePostRequest) Quote(quote string) *QuotePostRequest {
+	return r.set("quote", quote)
+}
+
+// Sets the source of the quote, which may contain HTML
+func (r *QuotePostRequest) Source(source string) *QuotePostRequest {
+	return r.set("source", source)
+}
+
+// Builder for link posts
+type LinkPostRequest struct {
+	postRequest[LinkPostRequest]
+}
+
+// Creates a link post request; Url is required
+func NewLinkPostRequest() *LinkPostRequest {
+	r := &LinkPostRequest{}
+	r.init(r, "link", []string{"url"})
+	return r
+}
+
+// Sets the URL linked to
+func (r *LinkPostRequest) Url(url string) *LinkPostRequest {
+	return r.set("url", url)
+}
+
+// Sets the link's title, defaulting to the linked page's title
+func (r *LinkPostRequest) Title(title string) *LinkPostRequest {
+	return r.set("title", title)
+}
+
+// Sets the text added below the link
+func (r *LinkPostRequest) Description(description string) *LinkPostRequest {
+	return r.set("description", description)
+}
+
+// Sets the URL of the image shown with the link
+func (r *LinkPostRequest) Thumbnail(thumbnail string) *LinkPostRequest {
+	return r.set("thumbnail", thumbnail)
+}
+
+// Sets the excerpt quoted from the linked page
+func (r *LinkPostRequest) Excerpt(excerpt string) *LinkPostRequest {
+	return r.set("excerpt", excerpt)
+}
+
+// Sets the author of the linked page
+func (r *LinkPostRequest) Author(author string) *LinkPostRequest {
+	return r.set("author", author)
+}
+
+// Builder for chat posts
+type ChatPostRequest struct {
+	postRequest[ChatPostRequest]
+}
+
+// Creates a chat post request; Conversation is required
+func NewChatPostRequest() *ChatPostRequest {
+	r := &ChatPostRequest{}
+	r.init(r, "chat", []string{"conversation"})
+	return r
+}
+
+// Sets the chat's title
+func (r *ChatPostRequest) Title(title string) *ChatPostRequest {
+	return r.set("title", title)
+}
+
+// Sets the chat's lines, each of the form "Name: phrase"
+func (r *ChatPostRequest) Conversation(lines ...string) *ChatPostRequest {
+	return r.set("conversation", strings.Join(lines, "\n"))
+}
+
+// Builder for audio posts
+type AudioPostRequest struct {
+	postRequest[AudioPostRequest]
+}
+
+// Creates an audio post request; either ExternalUrl or Data is required
+func NewAudioPostRequest() *AudioPostRequest {
+	r := &AudioPostRequest{}
+	r.init(r, "audio", []string{"external_url", "data"})
+	return r
+}
+
+// Sets the caption shown below the audio player
+func (r *AudioPostRequest) Caption(caption string) *AudioPostRequest {
+	return r.set("caption", caption)
+}
+
+// Sets the URL of the audio hosted elsewhere
+func (r *AudioPostRequest) ExternalUrl(externalUrl string) *AudioPostRequest {
+	return r.set("external_url", externalUrl)
+}
+
+// Sets the audio file to upload
+func (r *AudioPostRequest) Data(file *MediaFile) *AudioPostRequest {
+	r.files = []*MediaFile{file}
+	return r
+}
+
+// Builder for video posts
+type VideoPostRequest struct {
+	postRequest[VideoPostRequest]
+}
+
+// Creates a video post request; either Embed or Data is required
+func NewVideoPostRequest() *VideoPostRequest {
+	r := &VideoPostRequest{}
+	r.init(r, "video", []string{"embed", "data"})
+	return r
+}
+
+// Sets the caption shown below the video
+func (r *VideoPostRequest) Caption(caption string) *VideoPostRequest {
+	return r.set("caption", caption)
+}
+
+// Sets the HTML embed code or URL of the video
+func (r *VideoPostRequest) Embed(embed string) *VideoPostRequest {
+	return r.set("embed", embed)
+}
+
+// Sets the video file to upload
+func (r *VideoPostRequest) Data(file *MediaFile) *VideoPostRequest {
+	r.files = []*MediaFile{file}
+	return r
+}
+
+// Builder for the params of ReblogPost
+type ReblogRequest struct {
+	postRequest[ReblogRequest]
+}
+
+// Creates a reblog request, which has no required fields
+func NewReblogRequest() *ReblogRequest {
+	r := &ReblogRequest{}
+	r.init(r, "")
+	return r
+}
+
+// Sets the comment added to the reblog
+func (r *ReblogRequest) Comment(comment string) *ReblogRequest {
+	return r.set("comment", comment)
+}
+
+// Create a post from a request, uploading its files if it has any
+func CreatePostFromRequest(client ClientInterface, name string, request PostRequest) (*PostRef, error) {
+	return CreatePostFromRequestContext(context.Background(), client, name, request)
+}
+
+// Create a post from a request, aborting if ctx is done
+func CreatePostFromRequestContext(ctx context.Context, client ClientInterface, name string, request PostRequest) (*PostRef, error) {
+	params, err := request.Params()
+	if err != nil {
+		return nil, err
+	}
+	if files := request.Media(); len(files) > 0 {
+		return CreatePostWithMediaContext(ctx, client, name, params, files...)
+	}
+	return CreatePostContext(ctx, client, name, params)
+}
+
+// Edit a post from a request, uploading its files if it has any. Only the fields set on the request are sent,
+// so eg NewTextPostRequest().Tags("a") changes a text post's tags without requiring its body.
+func EditPostFromRequest(client ClientInterface, blogName string, postId uint64, request PostRequest) error {
+	return EditPostFromRequestContext(context.Background(), client, blogName, postId, request)
+}
+
+// Edit a post from a request, aborting if ctx is done
+func EditPostFromRequestContext(ctx context.Context, client ClientInterface, blogName string, postId uint64, request PostRequest) error {
+	params := request.EditParams()
+	files := request.Media()
+	if len(files) < 1 {
+		return EditPostContext(ctx, client, blogName, postId, params)
+	}
+	body, err := newLegacyPostBody(setPostId(postId, params), files)
+	if err != nil {
+		return err
+	}
+	_, err = sendPostBody(ctx, client, http.MethodPost, "/blog/%s/post/edit", blogName, body)
+	return err
+}
+
+// Reblog a post from a request, uploading its files if it has any
+func ReblogPostFromRequest(client ClientInterface, blogName string, postId uint64, reblogKey string, request PostRequest) (*PostRef, error) {
+	return ReblogPostFromRequestContext(context.Background(), client, blogName, postId, reblogKey, request)
+}
+
+// Reblog a post from a request, aborting if ctx is done
+func ReblogPostFromRequestContext(ctx context.Context, client ClientInterface, blogName string, postId uint64, reblogKey string, request PostRequest) (*PostRef, error) {
+	params, err := request.Params()
+	if err != nil {
+		return nil, err
+	}
+	files := request.Media()
+	if len(files) < 1 {
+		return ReblogPostContext(ctx, client, blogName, postId, reblogKey, params)
+	}
+	if reblogKey == "" {
+		return nil, errors.New("No reblog key provided")
+	}
+	params.Set("reblog_key", reblogKey)
+	body, err := newLegacyPostBody(setPostId(postId, params), files)
+	if err != nil {
+		return nil, err
+	}
+	return sendPostBody(ctx, client, http.MethodPost, "/blog/%s/post/reblog", blogName, body)
+}
@@ -0,0 +1,166 @@
+package tumblr
+
+import (
+	"testing"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+func TestTextPostRequest(t *testing.T) {
+	params, err := NewTextPostRequest().
+		Title("Hello").
+		Body("World").
+		Tags("cats", "dogs").
+		State(StateQueue).
+		PublishOn(time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)).
+		Params()
+	if err != nil {
+		t.Fatal("Valid request should produce params", err)
+	}
+	expected := url.Values{
+		"type": []string{"text"},
+		"title": []string{"Hello"},
+		"body": []string{"World"},
+		"tags": []string{"cats,dogs"},
+		"state": []string{"queue"},
+		"publish_on": []string{"2020-01-02T03:04:05Z"},
+	}
+	expectClientCallParams(t, "TextPostRequest", http.MethodPost, "", expected)(http.MethodPost, "", params)
+}
+
+func TestPostRequestRequiredFields(t *testing.T) {
+	invalid := map[string]PostRequest{
+		"text": NewTextPostRequest().Title("No body"),
+		"photo": NewPhotoPostRequest().Caption("No photo"),
+		"quote": NewQuotePostRequest().Source("Nobody"),
+		"link": NewLinkPostRequest().Title("Nowhere"),
+		"chat": NewChatPostRequest().Title("Silence"),
+		"audio": NewAudioPostRequest().Caption("Nothing"),
+		"video": NewVideoPostRequest().Caption("Nothing"),
+	}
+	for postType, request := range invalid {
+		if _, err := request.Params(); err == nil || !strings.HasPrefix(err.Error(), postType) {
+			t.Errorf("%s request missing its required field should generate an error, got %v", postType, err)
+		}
+	}
+	valid := []PostRequest{
+		NewPhotoPostRequest().Source("https://example.com/cat.jpg"),
+		NewPhotoPostRequest().Data(NewMediaFile(strings.NewReader(""), "cat.jpg", "image/jpeg")),
+		NewQuotePostRequest().Quote("Hi"),
+		NewLinkPostRequest().Url("https://example.com"),
+		NewChatPostRequest().Conversation("a: hi", "b: hello"),
+		NewAudioPostRequest().ExternalUrl("https://example.com/song.mp3"),
+		NewVideoPostRequest().Embed("https://youtube.com/x"),
+		NewReblogRequest(),
+	}
+	for _, request := range valid {
+		if _, err := request.Params(); err != nil {
+			t.Error("Request with its required fields should be valid", err)
+		}
+	}
+}
+
+func TestReblogRequest(t *testing.T) {
+	client := newTestClient(`{"response": {"id": 2}}`, nil)
+	params, _ := NewReblogRequest().Comment("Nice").Params()
+	if params.Get("type") != "" || params.Get("comment") != "Nice" {
+		t.Fatal("Reblog request should not set a type", params)
+	}
+	client.confirmExpectedSet = expectClientCallParams(t, "ReblogPost", http.MethodPost, blogPath("/blog/%s/post/reblog", "david"), url.Values{
+		"id": []string{"1"},
+		"reblog_key": []string{"key"},
+		"comment": []string{"Nice"},
+	})
+	if _, err := ReblogPost(client, "david", 1, "key", params); err != nil {
+		t.Fatal("Failed to reblog", err)
+	}
+}
+
+func TestCreatePostFromRequest(t *testing.T) {
+	client := newTestClient(`{"response": {"id": 1}}`, nil)
+	client.confirmExpectedSet = expectClientCallParams(t, "CreatePostFromRequest", http.MethodPost, blogPath("/blog/%s/post", "david"), url.Values{
+		"type": []string{"quote"},
+		"quote": []string{"Hi"},
+	})
+	if _, err := CreatePostFromRequest(client, "david", NewQuotePostRequest().Quote("Hi")); err != nil {
+		t.Fatal("Failed to create post", err)
+	}
+	if client.body != nil {
+		t.Fatal("Requests without files should be sent as a form")
+	}
+	client.confirmExpectedSet = nil
+	request := NewPhotoPostRequest().Data(NewMediaFile(strings.NewReader("image data"), "cat.jpg", "image/jpeg"))
+	if _, err := CreatePostFromRequest(client, "david", request); err != nil {
+		t.Fatal("Failed to create post", err)
+	}
+	parts := readMultipart(t, client.body.(*MultipartBody))
+	if parts["type"].data != "photo" || parts["data"].data != "image data" {
+		t.Fatal("Requests with files should be uploaded", parts)
+	}
+	if _, err := CreatePostFromRequest(client, "david", NewTextPostRequest()); err == nil {
+		t.Fatal("Invalid requests should not be sent")
+	}
+}
+
+func TestEditPostFromRequest(t *testing.T) {
+	client := newTestClient(`{"response": {"id": 1}}`, nil)
+	client.confirmExpectedSet = expectClientCallParams(t, "EditPostFromRequest", http.MethodPost, blogPath("/blog/%s/post/edit", "david"), url.Values{
+		"id": []string{"1"},
+		"type": []string{"quote"},
+		"quote": []string{"Hi"},
+	})
+	if err := EditPostFromRequest(client, "david", 1, NewQuotePostRequest().Quote("Hi")); err != nil || client.body != nil {
+		t.Fatal("Requests without files should be sent as a form", err)
+	}
+	client.confirmExpectedSet = expectClientCallParams(t, "EditPostFromRequest", http.MethodPost, blogPath("/blog/%s/post/edit", "david"), url.Values{})
+	request := NewPhotoPostRequest().Data(NewMediaFile(strings.NewReader("image data"), "cat.jpg", "image/jpeg"))
+	if err := EditPostFromRequest(client, "david", 1, request); err != nil {
+		t.Fatal("Failed to edit post", err)
+	}
+	parts := readMultipart(t, client.body.(*MultipartBody))
+	if parts["id"].data != "1" || parts["type"].data != "photo" || parts["data"].data != "image data" {
+		t.Fatal("Edits with files should be uploaded", parts)
+	}
+}
+
+func TestEditPostFromRequestOnlyTags(t *testing.T) {
+	client := newTestClient(`{"response": {"id": 1}}`, nil)
+	request := NewTextPostRequest().Tags("cats", "dogs")
+	if _, err := request.Params(); err == nil {
+		t.Fatal("Creating a text post should still require a body")
+	}
+	client.confirmExpectedSet = expectClientCallParams(t, "EditPostFromRequest", http.MethodPost, blogPath("/blog/%s/post/edit", "david"), url.Values{
+		"id": []string{"1"},
+		"type": []string{"text"},
+		"tags": []string{"cats,dogs"},
+	})
+	if err := EditPostFromRequest(client, "david", 1, request); err != nil {
+		t.Fatal("Edits should not require the fields needed to create a post", err)
+	}
+}
+
+func TestReblogPostFromRequest(t *testing.T) {
+	client := newTestClient(`{"response": {"id": 2}}`, nil)
+	client.confirmExpectedSet = expectClientCallParams(t, "ReblogPostFromRequest", http.MethodPost, blogPath("/blog/%s/post/reblog", "david"), url.Values{
+		"id": []string{"1"},
+		"reblog_key": []string{"key"},
+		"comment": []string{"Nice"},
+	})
+	if _, err := ReblogPostFromRequest(client, "david", 1, "key", NewReblogRequest().Comment("Nice")); err != nil || client.body != nil {
+		t.Fatal("Requests without files should be sent as a form", err)
+	}
+	client.confirmExpectedSet = expectClientCallParams(t, "ReblogPostFromRequest", http.MethodPost, blogPath("/blog/%s/post/reblog", "david"), url.Values{})
+	request := NewPhotoPostRequest().Data(NewMediaFile(strings.NewReader("image data"), "cat.jpg", "image/jpeg"))
+	if _, err := ReblogPostFromRequest(client, "david", 1, "", request); err == nil {
+		t.Fatal("Missing reblog key should generate an error")
+	}
+	if ref, err := ReblogPostFromRequest(client, "david", 1, "key", request); err != nil || ref.Id != 2 {
+		t.Fatal("Failed to reblog post", err)
+	}
+	parts := readMultipart(t, client.body.(*MultipartBody))
+	if parts["id"].data != "1" || parts["reblog_key"].data != "key" || parts["data"].data != "image data" {
+		t.Fatal("Reblogs with files should be uploaded", parts)
+	}
+}
@@ -1,12 +1,16 @@
 package tumblr
 
 import (
+	"context"
 	"testing"
 	"net/http"
 	"net/url"
 	"reflect"
 	"fmt"
 	"errors"
+	"encoding/json"
+	"strings"
+	"time"
 )
 
 func TestPostRefLike(t *testing.T) {
@@ -109,14 +113,14 @@ func TestPostDynamicAccessor (t *testing.T) {
 func TestQueryPostsReturnsClientError (t *testing.T) {
 	clientErr := errors.New("Client error")
 	client := newTestClient("", clientErr)
-	if _, err := queryPosts(client, "", "", url.Values{}); err == nil {
+	if _, err := queryPosts(context.Background(), client, "", "", url.Values{}); err == nil {
 		t.Fatal("Client error should be returned")
 	}
 }
 
 func TestQueryPostsReturnsJsonError (t *testing.T) {
 	client := newTestClient("{", nil)
-	if _, err := queryPosts(client, "", "", url.Values{}); err == nil {
+	if _, err := queryPosts(context.Background(), client, "", "", url.Values{}); err == nil {
 		t.Fatal("JSON Unmarshal error should be returned")
 	}
 }
@@ -134,6 +138,7 @@ func TestQueryPostsSuccess (t *testing.T) {
 		params,
 	)
 	response, err := queryPosts(
+		context.Background(),
 		client,
 		path,
 		blogName,
@@ -142,8 +147,8 @@ func TestQueryPostsSuccess (t *testing.T) {
 	if err != nil {
 		t.Fatal("Posts should have been returned")
 	}
-	if string(response.response.body) != string(client.response.body) {
-		t.Fatal("Response should match client's response")
+	if response.client != client || response.path != path || response.name != blogName {
+		t.Fatal("Response should keep what's needed to request neighbouring pages")
 	}
 }
 
@@ -195,6 +200,56 @@ func TestGetDrafts (t *testing.T) {
 	}
 }
 
+func TestGetDrafts_NextByBeforeId(t *testing.T) {
+	client := newTestClient(getPostsString(0, textPost(30, 300), textPost(20, 200)), nil)
+	params := url.Values{"limit": []string{"2"}}
+	drafts, err := GetDrafts(client, "david", params)
+	if err != nil {
+		t.Fatal("Failed to get drafts", err)
+	}
+	client.confirmExpectedSet = expectClientCallParams(t, "Drafts.Next", http.MethodGet, blogPath("/blog/%s/posts/draft", "david"), url.Values{
+		"limit": []string{"2"},
+		"before_id": []string{"20"},
+	})
+	if _, err = drafts.Next(); err != nil {
+		t.Fatal("Drafts should be paged by before_id", err)
+	}
+	if _, err = drafts.Prev(); err != NoPrevPageError {
+		t.Fatal("Drafts should have no previous page", err)
+	}
+}
+
+func TestPostRef_States(t *testing.T) {
+	client := newTestClient("{}", nil)
+	ref := &PostRef{client: client, MiniPost: MiniPost{Id: 1986, BlogName: "david"}}
+	path := blogPath("/blog/%s/post/edit", "david")
+	actions := map[string]func() error{
+		"published": ref.Publish,
+		"draft": ref.SaveAsDraft,
+		"private": ref.MakePrivate,
+		"queue": func() error {
+			return ref.Queue(time.Time{})
+		},
+	}
+	for state, action := range actions {
+		client.confirmExpectedSet = expectClientCallParams(t, state, http.MethodPost, path, url.Values{
+			"id": []string{"1986"},
+			"state": []string{state},
+		})
+		if err := action(); err != nil {
+			t.Fatalf("Failed to change state to %s: %v", state, err)
+		}
+	}
+	client.confirmExpectedSet = expectClientCallParams(t, "Queue", http.MethodPost, path, url.Values{
+		"id": []string{"1986"},
+		"state": []string{"queue"},
+		"publish_on": []string{"2020-01-02T03:04:05Z"},
+	})
+	if err := ref.Queue(time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)); err != nil {
+		t.Fatal("Failed to queue post", err)
+	}
+}
+
 func TestGetSubmissions (t *testing.T) {
 	client := newTestClient("{}", nil)
 	blogName := "david"
@@ -213,7 +268,7 @@ func TestGetSubmissions (t *testing.T) {
 
 func TestDoPostMissingBlogError (t *testing.T) {
 	client := newTestClient("{}", nil)
-	if _,err := doPost(client, "", "", url.Values{}); err == nil {
+	if _,err := doPost(context.Background(), client, "", "", url.Values{}); err == nil {
 		t.Fatal("Blog name should be required")
 	}
 }
@@ -221,14 +276,14 @@ func TestDoPostMissingBlogError (t *testing.T) {
 func TestDoPostClientError (t *testing.T) {
 	clientErr := errors.New("Client error")
 	client := newTestClient("{}", clientErr)
-	if _,err := doPost(client, "", "blog", url.Values{}); err != clientErr {
+	if _,err := doPost(context.Background(), client, "", "blog", url.Values{}); err != clientErr {
 		t.Fatal("Client error should be returned")
 	}
 }
 
 func TestDoPostJsonError (t *testing.T) {
 	client := newTestClient("{", nil)
-	if _,err := doPost(client, "", "blog", url.Values{}); err == nil {
+	if _,err := doPost(context.Background(), client, "", "blog", url.Values{}); err == nil {
 		t.Fatal("Json error should be returned")
 	}
 }
@@ -246,7 +301,7 @@ func TestDoPostSuccess (t *testing.T) {
 		blogPath(path, blog),
 		params,
 	)
-	if result,err := doPost(client, path, blog, params); err != nil {
+	if result,err := doPost(context.Background(), client, path, blog, params); err != nil {
 		t.Fatal("Do post should succeed")
 	} else {
 		if result.Id != postId {
@@ -318,6 +373,113 @@ func TestEditPost (t *testing.T) {
 	EditPost(client, blog, postId, params)
 }
 
+func TestCreateNPFPost(t *testing.T) {
+	client := newTestClient(`{"response": {"id": "1986", "state": "published"}}`, nil)
+	blog := "david"
+	client.confirmExpectedSet = expectClientCallParams(t, "CreateNPFPost", http.MethodPost, blogPath("/blog/%s/posts", blog), url.Values{})
+	ref, err := CreateNPFPost(client, blog, NPFPostRequest{
+		Content: ContentBlocks{&TextBlock{Text: "Hello"}},
+		State: StateQueue,
+		PublishOn: time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC),
+		Tags: []string{"cats", "dogs"},
+	})
+	if err != nil {
+		t.Fatal("Failed to create post", err)
+	}
+	if ref.Id != 1986 || ref.BlogName != blog || ref.client != client {
+		t.Fatal("String post ID should be decoded into the PostRef", ref)
+	}
+	if client.body.ContentType() != "application/json" {
+		t.Fatal("Post should be sent as JSON")
+	}
+	sent := map[string]interface{}{}
+	if err = json.Unmarshal(client.body.(*JSONBody).Bytes(), &sent); err != nil {
+		t.Fatal("Failed to decode sent body", err)
+	}
+	if sent["tags"] != "cats,dogs" || sent["state"] != "queue" || sent["publish_on"] != "2020-01-02T03:04:05Z" {
+		t.Fatal("Post fields not encoded correctly", sent)
+	}
+	if content := sent["content"].([]interface{}); len(content) != 1 || content[0].(map[string]interface{})["type"] != "text" {
+		t.Fatal("Content blocks not encoded correctly", sent)
+	}
+	if _, present := sent["date"]; present {
+		t.Fatal("Unset times should be omitted", sent)
+	}
+	if _, err = CreateNPFPost(client, "", NPFPostRequest{}); err == nil {
+		t.Fatal("Missing blog name should generate an error")
+	}
+}
+
+func TestEditNPFPost(t *testing.T) {
+	client := newTestClient(`{"response": {"id": 1986}}`, nil)
+	ref := &PostRef{client: client, MiniPost: MiniPost{Id: 1986, BlogName: "david"}}
+	client.confirmExpectedSet = expectClientCallParams(t, "EditNPF", http.MethodPut, blogPath("/blog/%s/posts/1986", "david"), url.Values{})
+	edited, err := ref.EditNPF(NPFPostRequest{Content: ContentBlocks{&TextBlock{Text: "Edited"}}})
+	if err != nil || edited.Id != 1986 {
+		t.Fatal("Failed to edit post", err)
+	}
+}
+
+func TestCreatePostWithMedia(t *testing.T) {
+	client := newTestClient(`{"response": {"id": 1986}}`, nil)
+	client.confirmExpectedSet = expectClientCallParams(t, "CreatePostWithMedia", http.MethodPost, blogPath("/blog/%s/post", "david"), url.Values{})
+	params := url.Values{"type": []string{"photo"}, "caption": []string{"Cats"}}
+	files := []*MediaFile{
+		NewMediaFile(strings.NewReader("first"), "a.jpg", "image/jpeg"),
+		NewMediaFile(strings.NewReader("second"), "b.jpg", "image/jpeg"),
+	}
+	ref, err := CreatePostWithMedia(client, "david", params, files...)
+	if err != nil || ref.Id != 1986 {
+		t.Fatal("Failed to create post", err)
+	}
+	parts := readMultipart(t, client.body.(*MultipartBody))
+	if parts["type"].data != "photo" || parts["caption"].data != "Cats" || parts["data[0]"].data != "first" || parts["data[1]"].data != "second" {
+		t.Fatal("Params and files should be sent as parts", parts)
+	}
+}
+
+func TestCreatePostWithSingleMediaFile(t *testing.T) {
+	client := newTestClient(`{"response": {"id": 1986}}`, nil)
+	params := url.Values{"type": []string{"audio"}}
+	if _, err := CreatePostWithMedia(client, "david", params, NewMediaFile(strings.NewReader("song"), "a.mp3", "audio/mpeg")); err != nil {
+		t.Fatal("Failed to create post", err)
+	}
+	if parts := readMultipart(t, client.body.(*MultipartBody)); parts["data"].data != "song" {
+		t.Fatal("A single file should be sent as plain data", parts)
+	}
+	client.body = nil
+	if _, err := CreatePostWithMedia(client, "david", params, nil); err != NilMediaFileError || client.body != nil {
+		t.Fatal("Nil files should generate an error before sending", err)
+	}
+	if _, err := CreateNPFPostWithMedia(client, "david", NPFPostRequest{}, nil); err != NilMediaFileError || client.body != nil {
+		t.Fatal("Nil files should generate an error before sending", err)
+	}
+}
+
+func TestCreateNPFPostWithMedia(t *testing.T) {
+	client := newTestClient(`{"response": {"id": "1986"}}`, nil)
+	client.confirmExpectedSet = expectClientCallParams(t, "CreateNPFPostWithMedia", http.MethodPost, blogPath("/blog/%s/posts", "david"), url.Values{})
+	file := NewMediaFile(strings.NewReader("image data"), "cat.jpg", "image/jpeg").WithIdentifier("cat")
+	post := NPFPostRequest{Content: ContentBlocks{&ImageBlock{Media: []MediaObject{file.MediaObject()}}}}
+	if _, err := CreateNPFPostWithMedia(client, "david", post, file); err != nil {
+		t.Fatal("Failed to create post", err)
+	}
+	parts := readMultipart(t, client.body.(*MultipartBody))
+	if parts["cat"].data != "image data" || !strings.Contains(parts["json"].data, `"identifier":"cat"`) {
+		t.Fatal("Post should be sent as JSON with files named by identifier", parts)
+	}
+	if _, err := CreateNPFPostWithMedia(client, "david", post, NewMediaFile(strings.NewReader(""), "a.jpg", "image/jpeg")); err == nil {
+		t.Fatal("Files without an identifier should generate an error")
+	}
+}
+
+func TestNPFPostRequiresBodyClient(t *testing.T) {
+	client := struct{ ClientInterface }{newTestClient("{}", nil)}
+	if _, err := CreateNPFPost(client, "david", NPFPostRequest{}); err != BodyNotSupportedError {
+		t.Fatal("Clients without body support should generate an error", err)
+	}
+}
+
 func TestPostRef_Edit(t *testing.T) {
 	client := newTestClient("{}", nil)
 	blog := "david"
@@ -410,65 +572,136 @@ func TestPostRef_ReblogOnBlog(t *testing.T) {
 
 }
 
+func TestGetPost(t *testing.T) {
+	client := newTestClient(getPostsString(1, textPost(1986, 100)), nil)
+	client.confirmExpectedSet = expectClientCallParams(t, "GetPost", http.MethodGet, blogPath("/blog/%s/posts", "david"), setPostId(1986, url.Values{}))
+	post, err := GetPost(client, "david", 1986)
+	if err != nil {
+		t.Fatal("Failed to get post", err)
+	}
+	if text, ok := post.(*TextPost); !ok || text.Id != 1986 || text.client != client {
+		t.Fatal("Post should be decoded into its type", post)
+	}
+	client.response = Response{body: []byte(getPostsString(0))}
+	if _, err = GetPost(client, "david", 1986); !errors.Is(err, ErrNotFound) {
+		t.Fatal("Missing post should generate a not found error", err)
+	}
+}
+
+func TestGetNPFPost(t *testing.T) {
+	client := newTestClient(`{"response": {"id": 1986, "type": "blocks", "content": [{"type": "text", "text": "Hello"}]}}`, nil)
+	client.confirmExpectedSet = expectClientCallParams(t, "GetNPFPost", http.MethodGet, blogPath("/blog/%s/posts/1986", "david"), url.Values{})
+	post, err := GetNPFPost(client, "david", 1986, nil)
+	if err != nil {
+		t.Fatal("Failed to get post", err)
+	}
+	if p := post.GetSelf(); p.Id != 1986 || len(p.Content) != 1 || p.client != client {
+		t.Fatal("NPF post not decoded correctly", post)
+	}
+	client.response = Response{body: []byte(`{"response": {"id": 1, "type": "quote", "text": "Hi"}}`)}
+	client.confirmExpectedSet = nil
+	if post, _ = GetNPFPost(client, "david", 1, url.Values{"post_format": []string{"legacy"}}); post.(*QuotePost).Text != "Hi" {
+		t.Fatal("Legacy posts should be decoded into their type", post)
+	}
+}
+
+func TestPostRef_Fetch(t *testing.T) {
+	client := newTestClient(getPostsString(1, textPost(1986, 100)), nil)
+	ref := NewPostRefById(client, 1986)
+	if _, err := ref.Fetch(); err != NoBlogNameError {
+		t.Fatal("Fetch without a blog name should generate NoBlogNameError")
+	}
+	ref.BlogName = "david"
+	client.confirmExpectedSet = expectClientCallParams(t, "Fetch", http.MethodGet, blogPath("/blog/%s/posts", "david"), setPostId(1986, url.Values{}))
+	if post, err := ref.Fetch(); err != nil || post.GetSelf().Id != 1986 {
+		t.Fatal("Failed to fetch post", err)
+	}
+}
+
 func TestPosts_All(t *testing.T) {
 	client := newTestClient("{}", nil)
 	posts, err := GetPosts(client, "blog", url.Values{})
 	if err != nil {
 		t.Fatal("Failed to get posts")
 	}
-	posts.Posts = []MiniPost{MiniPost{Type:"quote"}}
-	if posts.parsedPosts != nil {
-		t.Fatal("Posts initialized with non-nil parsed posts")
+	posts.Posts = []MiniPost{MiniPost{Id: 1, Type:"quote"}}
+	if posts.FullPosts != nil {
+		t.Fatal("Posts initialized with non-nil full posts")
 	}
 	all, err := posts.All()
 	if err != nil {
 		t.Fatal("Failed to parse Posts")
 	}
-	if posts.parsedPosts == nil {
-		t.Fatal("Posts does not cache parsed posts after All()")
+	if posts.FullPosts == nil {
+		t.Fatal("Posts does not cache full posts after All()")
 	}
-	if len(all) != 1 {
+	if len(all) != 1 || all[0].GetSelf().Id != 1 {
 		t.Fatal("Failed to correctly posts from mini posts array")
 	}
 }
 
+func TestPosts_AllDecodesConcreteTypes(t *testing.T) {
+	client := newTestClient(`{"response": {"posts": [{"id": 1, "type": "quote", "text": "Hi"}]}}`, nil)
+	posts, err := GetPosts(client, "blog", url.Values{})
+	if err != nil {
+		t.Fatal("Failed to get posts", err)
+	}
+	all, err := posts.All()
+	if err != nil || len(all) != 1 {
+		t.Fatal("Failed to get full posts", err)
+	}
+	if quote, ok := all[0].(*QuotePost); !ok || quote.Text != "Hi" || quote.client != client {
+		t.Fatal("Full posts should be decoded into their concrete types with the client set", all[0])
+	}
+}
+
 func TestPosts_AllWithJsonError(t *testing.T) {
-	client := newTestClient("{}", nil)
+	client := newTestClient(`{"response": {"posts": [{"id": 1, "type": "quote", "text": 5}]}}`, nil)
 	posts, err := GetPosts(client, "blog", url.Values{})
 	if err != nil {
-		t.Fatal("Failed to get posts")
+		t.Fatal("A malformed post should not fail the whole page", err)
+	}
+	if len(posts.Posts) != 1 || posts.FullPosts != nil {
+		t.Fatal("Full posts should not be decoded before All()")
 	}
-	posts.response.body = []byte("{")
 	_, err = posts.All()
 	if err == nil {
 		t.Fatal("Failed to return JSON parse error")
 	}
 }
 
+func TestPosts_RoundTrip(t *testing.T) {
+	client := newTestClient(`{"response": {"total_posts": 5, "posts": [{"id": 1, "type": "quote", "text": "Hi"}, {"id": 2, "type": "photo", "caption": "Cat"}]}}`, nil)
+	posts, _ := GetPosts(client, "blog", url.Values{})
+	encoded, err := json.Marshal(posts)
+	if err != nil {
+		t.Fatal("Failed to encode posts", err)
+	}
+	decoded := Posts{}
+	if err = json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatal("Failed to decode posts", err)
+	}
+	all, err := decoded.All()
+	if err != nil || len(all) != 2 || decoded.TotalPosts != 5 || len(decoded.Posts) != 2 || decoded.Posts[1].Id != 2 {
+		t.Fatal("Posts should survive re-encoding", err)
+	}
+	if quote, ok := all[0].(*QuotePost); !ok || quote.Text != "Hi" {
+		t.Fatal("Full posts should survive re-encoding", all[0])
+	}
+	if photo, ok := all[1].(*PhotoPost); !ok || photo.Caption != "Cat" {
+		t.Fatal("Full posts should survive re-encoding", all[1])
+	}
+}
+
 func TestPosts_Get(t *testing.T) {
-	client := newTestClient("{}", nil)
+	client := newTestClient(`{"response": {"posts": [{"id": 1, "type": "quote"}]}}`, nil)
 	posts, err := GetPosts(client, "blog", url.Values{})
 	if err != nil {
 		t.Fatal("Failed to get posts")
 	}
-	mini := MiniPost{Type:"quote"}
-	posts.Posts = []MiniPost{mini}
-	mockResponse := struct{
-		Response struct{
-				 Posts []QuotePost `json:"posts"`
-			 } `json:"response"`
-	}{}
-	mockResponse.Response.Posts = []QuotePost{QuotePost{Post: Post{PostRef: PostRef{MiniPost: mini}}}}
-	posts.response.body = []byte(jsonStringify(mockResponse))
-	if posts.parsedPosts != nil {
-		t.Fatal("Posts initialized with non-nil parsed posts")
-	}
 	post := posts.Get(0)
-	if posts.parsedPosts == nil {
-		t.Fatal("Get() should cache full array of parsed posts")
-	}
-	if post.GetSelf().Type != mini.Type {
-		t.Fatalf("Get() should return correct type of mini post; expected %s, got %s", post.GetSelf().Type, mini.Type)
+	if post == nil || post.GetSelf().Type != "quote" {
+		t.Fatal("Get() should return the full post at the index", post)
 	}
 	if post := posts.Get(10); post != nil {
 		t.Fatal("Getting out of bounds should generate error")
@@ -476,13 +709,119 @@ func TestPosts_Get(t *testing.T) {
 }
 
 func TestPosts_GetWithAllError(t *testing.T) {
-	client := newTestClient("{}", nil)
+	client := newTestClient(`{"response": {"posts": [{"id": 1, "type": "quote", "text": 5}]}}`, nil)
 	posts, err := GetPosts(client, "blog", url.Values{})
 	if err != nil {
 		t.Fatal("Failed to get posts")
 	}
-	posts.response.body = []byte("{")
 	if post := posts.Get(0); post != nil {
 		t.Fatal("Get() should return nil on error from All()")
 	}
-}
\ No newline at end of file
+}
+
+func TestPosts_NextByOffset(t *testing.T) {
+	client := newTestClient(getPostsString(5, textPost(1, 0), textPost(2, 0)), nil)
+	params := url.Values{}
+	params.Set("type", "text")
+	params.Set("limit", "2")
+	posts, err := GetPosts(client, "david", params)
+	if err != nil {
+		t.Fatal("Failed to get posts")
+	}
+	expected := copyParams(params)
+	expected.Set("offset", "2")
+	client.confirmExpectedSet = expectClientCallParams(t, "Posts.Next", http.MethodGet, blogPath("/blog/%s/posts", "david"), expected)
+	next, err := posts.Next()
+	if err != nil {
+		t.Fatal("Next page should be available while offset is less than total", err)
+	}
+	if posts.params.Get("offset") != "" {
+		t.Fatal("Next page should not modify params of previous result")
+	}
+	expected.Set("offset", "4")
+	if last, err := next.Next(); err != nil {
+		t.Fatal("Next page should be available while offset is less than total", err)
+	} else {
+		last.Posts = last.Posts[:1]
+		if _, err := last.Next(); err != NoNextPageError {
+			t.Fatal("Offset reaching total should mean no next page")
+		}
+	}
+	if _, err := next.NextByBeforeId(); err != MixedPaginationMethodsError {
+		t.Fatal("Changing pagination should generate error")
+	}
+}
+
+func TestPosts_NextEndsOnShortPage(t *testing.T) {
+	client := newTestClient(getPostsString(0, textPost(1, 0)), nil)
+	posts, _ := GetDrafts(client, "david", url.Values{"limit": []string{"2"}})
+	if _, err := posts.Next(); err != NoNextPageError {
+		t.Fatal("Page smaller than the limit should be the last")
+	}
+	posts.Posts = nil
+	posts.params.Del("limit")
+	if _, err := posts.Next(); err != NoNextPageError {
+		t.Fatal("Empty page should be the last")
+	}
+}
+
+func TestPosts_NextBeyondMaxLimit(t *testing.T) {
+	page := []Post{}
+	for i := 0; i < 20; i++ {
+		page = append(page, textPost(uint64(100 - i), 0))
+	}
+	client := newTestClient(getPostsString(0, page...), nil)
+	drafts, _ := GetDrafts(client, "david", url.Values{"limit": []string{"50"}})
+	client.confirmExpectedSet = expectClientCallParams(t, "Posts.Next", http.MethodGet, blogPath("/blog/%s/posts/draft", "david"), url.Values{
+		"limit": []string{"50"},
+		"before_id": []string{"81"},
+	})
+	if _, err := drafts.Next(); err != nil {
+		t.Fatal("Full page should not be the last when the limit exceeds what the API returns", err)
+	}
+	client.confirmExpectedSet = nil
+	posts, _ := GetPosts(client, "david", url.Values{"limit": []string{"50"}, "offset": []string{"40"}})
+	client.confirmExpectedSet = expectClientCallParams(t, "Posts.Prev", http.MethodGet, blogPath("/blog/%s/posts", "david"), url.Values{
+		"limit": []string{"50"},
+		"offset": []string{"20"},
+	})
+	if _, err := posts.Prev(); err != nil {
+		t.Fatal("Previous page should step back by the page size the API honours", err)
+	}
+}
+
+func TestPosts_NextByBeforeId(t *testing.T) {
+	client := newTestClient(getPostsString(0, textPost(10, 0), textPost(9, 0)), nil)
+	params := url.Values{}
+	params.Set("before_id", "11")
+	posts, _ := GetDrafts(client, "david", params)
+	client.confirmExpectedSet = expectClientCallParams(t, "Posts.Next", http.MethodGet, blogPath("/blog/%s/posts/draft", "david"), url.Values{"before_id": []string{"9"}})
+	next, err := posts.Next()
+	if err != nil {
+		t.Fatal("Next page should be requested before the last post id", err)
+	}
+	if _, err := next.Prev(); err != NoPrevPageError {
+		t.Fatal("Pages requested by before_id cannot go back")
+	}
+	if _, err := next.NextByOffset(); err != MixedPaginationMethodsError {
+		t.Fatal("Changing pagination should generate error")
+	}
+}
+
+func TestPosts_Prev(t *testing.T) {
+	client := newTestClient(getPostsString(10, textPost(1, 0), textPost(2, 0)), nil)
+	posts, _ := GetQueue(client, "david", url.Values{"offset": []string{"3"}})
+	client.confirmExpectedSet = expectClientCallParams(t, "Posts.Prev", http.MethodGet, blogPath("/blog/%s/posts/queue", "david"), url.Values{"offset": []string{"1"}})
+	prev, err := posts.Prev()
+	if err != nil {
+		t.Fatal("Previous page should be available while offset is positive", err)
+	}
+	client.confirmExpectedSet = expectClientCallParams(t, "Posts.Prev", http.MethodGet, blogPath("/blog/%s/posts/queue", "david"), url.Values{"offset": []string{"0"}})
+	first, err := prev.Prev()
+	if err != nil {
+		t.Fatal("Previous page offset should not go below 0", err)
+	}
+	if _, err := first.Prev(); err != NoPrevPageError {
+		t.Fatal("Previous page from 0 offset should generate an error")
+	}
+}
@@ -0,0 +1,93 @@
+package tumblr
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Path segments which precede the post ID in permalinks on a blog's own host
+var blogPostPaths = map[string]bool{
+	"post": true,
+	"image": true,
+	"private": true,
+}
+
+// Parses a post permalink into a PostRef with BlogName and Id set. Recognized shapes are
+// https://staff.tumblr.com/post/123456/slug, https://www.tumblr.com/staff/123456/slug,
+// https://www.tumblr.com/blog/view/staff/123456, https://www.tumblr.com/reblog/staff/123456/reblogkey
+// (which also sets ReblogKey), and /post/, /image/ or /private/ URLs on custom domains.
+// Permalinks rarely carry the reblog key Like and Reblog need, so use ResolvePostURL to fetch it.
+func ParsePostURL(client ClientInterface, postUrl string) (*PostRef, error) {
+	raw := strings.TrimSpace(postUrl)
+	if !strings.Contains(raw, "://") {
+		raw = "https://" + raw
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return nil, err
+	}
+	host := strings.ToLower(strings.TrimSuffix(u.Hostname(), "."))
+	if host == "" {
+		return nil, fmt.Errorf("No host in post URL %s", postUrl)
+	}
+	segments := strings.FieldsFunc(u.Path, func(r rune) bool {
+		return r == '/'
+	})
+	var blog BlogIdentifier
+	var idSegment, reblogKey string
+	if tumblrHosts[host] {
+		if len(segments) > 0 && segments[0] == "reblog" {
+			segments = segments[1:]
+			if len(segments) > 2 {
+				reblogKey = segments[2]
+			}
+		}
+		if blog, err = blogIdentifierFromHost(host, "/" + strings.Join(segments, "/")); err != nil {
+			return nil, fmt.Errorf("Unrecognized post URL %s", postUrl)
+		}
+		// The post ID follows the blog name, which comes first once any blog/view/ prefix is skipped
+		segments = tumblrBlogSegments(strings.Join(segments, "/"))
+		if len(segments) > 1 {
+			idSegment = segments[1]
+		}
+	} else {
+		if blog, err = blogIdentifierFromHost(host, ""); err != nil {
+			return nil, err
+		}
+		if len(segments) > 1 && blogPostPaths[segments[0]] {
+			idSegment = segments[1]
+		}
+	}
+	id, err := strconv.ParseUint(idSegment, 10, 64)
+	if err != nil || id == 0 {
+		return nil, fmt.Errorf("No post ID found in URL %s", postUrl)
+	}
+	ref := NewPostRefById(client, id)
+	ref.BlogName = blog.Name
+	if ref.BlogName == "" {
+		ref.BlogName = blog.String()
+	}
+	ref.ReblogKey = reblogKey
+	return ref, nil
+}
+
+// Parses a post permalink and retrieves the post, returning a PostRef with its reblog key set
+func ResolvePostURL(client ClientInterface, postUrl string) (*PostRef, error) {
+	return ResolvePostURLContext(context.Background(), client, postUrl)
+}
+
+// Parses a post permalink and retrieves the post, aborting if ctx is done
+func ResolvePostURLContext(ctx context.Context, client ClientInterface, postUrl string) (*PostRef, error) {
+	ref, err := ParsePostURL(client, postUrl)
+	if err != nil {
+		return nil, err
+	}
+	post, err := ref.FetchContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &post.GetSelf().PostRef, nil
+}
@@ -0,0 +1,59 @@
+package tumblr
+
+import (
+	"testing"
+	"net/url"
+	"net/http"
+)
+
+func TestParsePostURL(t *testing.T) {
+	cases := map[string]MiniPost{
+		"https://staff.tumblr.com/post/123456/slug": MiniPost{Id: 123456, BlogName: "staff"},
+		"https://staff.tumblr.com/post/123456": MiniPost{Id: 123456, BlogName: "staff"},
+		"staff.tumblr.com/image/123456": MiniPost{Id: 123456, BlogName: "staff"},
+		"https://www.tumblr.com/staff/123456": MiniPost{Id: 123456, BlogName: "staff"},
+		"https://tumblr.com/staff/123456/slug?source=share": MiniPost{Id: 123456, BlogName: "staff"},
+		"https://www.tumblr.com/blog/view/staff/123456": MiniPost{Id: 123456, BlogName: "staff"},
+		"https://www.tumblr.com/reblog/staff/123456/abcdef": MiniPost{Id: 123456, BlogName: "staff", ReblogKey: "abcdef"},
+		"https://www.example.com/post/123456/slug": MiniPost{Id: 123456, BlogName: "www.example.com"},
+		"https://www.tumblr.com/blog/view/view/123456": MiniPost{Id: 123456, BlogName: "view"},
+		"https://www.tumblr.com/blog/view/blog/123456": MiniPost{Id: 123456, BlogName: "blog"},
+		"https://www.tumblr.com/blog/blog/123456": MiniPost{Id: 123456, BlogName: "blog"},
+		"https://www.tumblr.com/reblog/view/123456/abcdef": MiniPost{Id: 123456, BlogName: "view", ReblogKey: "abcdef"},
+	}
+	client := newTestClient("{}", nil)
+	for input, expected := range cases {
+		ref, err := ParsePostURL(client, input)
+		if err != nil || ref.MiniPost != expected || ref.client != client {
+			t.Errorf("Expected %s to parse as %+v, got %+v (%v)", input, expected, ref, err)
+		}
+	}
+	for _, input := range []string{
+		"",
+		"https://staff.tumblr.com/",
+		"https://staff.tumblr.com/tagged/cats",
+		"https://www.tumblr.com/staff",
+		"https://www.tumblr.com/dashboard/123456",
+		"https://www.example.com/about/123456",
+		"https://staff.tumblr.com/post/slug",
+	} {
+		if _, err := ParsePostURL(client, input); err == nil {
+			t.Errorf("Expected %q to fail to parse", input)
+		}
+	}
+}
+
+func TestResolvePostURL(t *testing.T) {
+	client := newTestClient(`{"response": {"posts": [{"id": 123456, "type": "text", "blog_name": "staff", "reblog_key": "abcdef"}]}}`, nil)
+	client.confirmExpectedSet = expectClientCallParams(t, "GetPost", http.MethodGet, "/blog/staff/posts", url.Values{"id": []string{"123456"}})
+	ref, err := ResolvePostURL(client, "https://www.tumblr.com/staff/123456")
+	if err != nil {
+		t.Fatal("Post should be resolved", err)
+	}
+	if ref.Id != 123456 || ref.ReblogKey != "abcdef" || ref.client != client {
+		t.Fatal("Resolved post should have its reblog key and client set", ref)
+	}
+	if _, err := ResolvePostURL(client, "https://www.tumblr.com/staff"); err == nil {
+		t.Fatal("Unparseable URL should generate an error")
+	}
+}
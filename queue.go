@@ -0,0 +1,73 @@
+package tumblr
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Queue settings (posts published per day and the hours they're published between) are not supported:
+// the published API documentation has no endpoint for reading or updating them.
+
+// Moves a queued post to just after another queued post, or to the front of the queue if insertAfter is 0
+func ReorderQueue(client ClientInterface, name string, postId, insertAfter uint64) error {
+	return ReorderQueueContext(context.Background(), client, name, postId, insertAfter)
+}
+
+// Moves a queued post to just after another queued post, aborting if ctx is done
+func ReorderQueueContext(ctx context.Context, client ClientInterface, name string, postId, insertAfter uint64) error {
+	params := setParamsUint(postId, url.Values{}, "post_id")
+	params = setParamsUint(insertAfter, params, "insert_after")
+	_, err := doRequest(ctx, client, http.MethodPost, blogPath("/blog/%s/posts/queue/reorder", name), params)
+	return err
+}
+
+// Randomly reorders a blog's queue
+func ShuffleQueue(client ClientInterface, name string) error {
+	return ShuffleQueueContext(context.Background(), client, name)
+}
+
+// Randomly reorders a blog's queue, aborting if ctx is done
+func ShuffleQueueContext(ctx context.Context, client ClientInterface, name string) error {
+	_, err := doRequest(ctx, client, http.MethodPost, blogPath("/blog/%s/posts/queue/shuffle", name), nil)
+	return err
+}
+
+// Copies params, setting them to queue the post for publishing at the given time; usable with CreatePost or EditPost
+func ScheduleParams(params url.Values, at time.Time) url.Values {
+	params = copyParams(params)
+	params.Set("state", string(StateQueue))
+	params.Set("publish_on", at.UTC().Format(time.RFC3339))
+	return params
+}
+
+// Moves an existing post into the queue, to be published at the given time
+func SchedulePost(client ClientInterface, name string, postId uint64, at time.Time) error {
+	return SchedulePostContext(context.Background(), client, name, postId, at)
+}
+
+// Moves an existing post into the queue, aborting if ctx is done
+func SchedulePostContext(ctx context.Context, client ClientInterface, name string, postId uint64, at time.Time) error {
+	return EditPostContext(ctx, client, name, postId, ScheduleParams(url.Values{}, at))
+}
+
+// Moves a queued post of the given blog reference to just after another queued post, or to the front if insertAfter is 0
+func (b *BlogRef) ReorderQueue(postId, insertAfter uint64) error {
+	return b.ReorderQueueContext(context.Background(), postId, insertAfter)
+}
+
+// Moves a queued post of the given blog reference, aborting if ctx is done
+func (b *BlogRef) ReorderQueueContext(ctx context.Context, postId, insertAfter uint64) error {
+	return ReorderQueueContext(ctx, b.client, b.Name, postId, insertAfter)
+}
+
+// Randomly reorders the queue of the given blog reference
+func (b *BlogRef) ShuffleQueue() error {
+	return b.ShuffleQueueContext(context.Background())
+}
+
+// Randomly reorders the queue of the given blog reference, aborting if ctx is done
+func (b *BlogRef) ShuffleQueueContext(ctx context.Context) error {
+	return ShuffleQueueContext(ctx, b.client, b.Name)
+}
@@ -0,0 +1,44 @@
+package tumblr
+
+import (
+	"testing"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+func TestReorderQueue(t *testing.T) {
+	client := newTestClient("{}", nil)
+	client.confirmExpectedSet = expectClientCallParams(t, "ReorderQueue", http.MethodPost, blogPath("/blog/%s/posts/queue/reorder", "david"), url.Values{
+		"post_id": []string{"2"},
+		"insert_after": []string{"0"},
+	})
+	if err := NewBlogRef(client, "david").ReorderQueue(2, 0); err != nil {
+		t.Fatal("Failed to reorder queue", err)
+	}
+}
+
+func TestShuffleQueue(t *testing.T) {
+	client := newTestClient("{}", nil)
+	client.confirmExpectedSet = expectClientCallParams(t, "ShuffleQueue", http.MethodPost, blogPath("/blog/%s/posts/queue/shuffle", "david"), url.Values{})
+	if err := NewBlogRef(client, "david").ShuffleQueue(); err != nil {
+		t.Fatal("Failed to shuffle queue", err)
+	}
+}
+
+func TestSchedulePost(t *testing.T) {
+	at := time.Date(2020, 1, 2, 3, 4, 5, 0, time.FixedZone("EST", -5 * 60 * 60))
+	params := ScheduleParams(url.Values{"type": []string{"text"}}, at)
+	if params.Get("state") != "queue" || params.Get("publish_on") != "2020-01-02T08:04:05Z" || params.Get("type") != "text" {
+		t.Fatal("Schedule params not set correctly", params)
+	}
+	client := newTestClient("{}", nil)
+	client.confirmExpectedSet = expectClientCallParams(t, "SchedulePost", http.MethodPost, blogPath("/blog/%s/post/edit", "david"), url.Values{
+		"id": []string{"1986"},
+		"state": []string{"queue"},
+		"publish_on": []string{"2020-01-02T08:04:05Z"},
+	})
+	if err := SchedulePost(client, "david", 1986, at); err != nil {
+		t.Fatal("Failed to schedule post", err)
+	}
+}
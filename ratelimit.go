@@ -0,0 +1,356 @@
+package tumblr
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Rate limit state reported by the API's response headers
+type RateLimitInfo struct {
+	// Whether the hourly limit headers were present
+	HasHourly bool
+	HourLimit int64
+	HourRemaining int64
+	// Time until the hourly window resets
+	HourReset time.Duration
+	// Whether the daily limit headers were present
+	HasDaily bool
+	DayLimit int64
+	DayRemaining int64
+	// Time until the daily window resets
+	DayReset time.Duration
+	// Value of the Retry-After header, 0 if absent
+	RetryAfter time.Duration
+}
+
+// Parses the rate limit headers captured on the response
+func (r *Response) RateLimit() RateLimitInfo {
+	info := RateLimitInfo{}
+	if r.Headers == nil {
+		return info
+	}
+	info.HourLimit, info.HasHourly = headerInt(r.Headers, "X-Ratelimit-Perhour-Limit")
+	info.HourRemaining, _ = headerInt(r.Headers, "X-Ratelimit-Perhour-Remaining")
+	info.HourReset = headerSeconds(r.Headers, "X-Ratelimit-Perhour-Reset")
+	info.DayLimit, info.HasDaily = headerInt(r.Headers, "X-Ratelimit-Perday-Limit")
+	info.DayRemaining, _ = headerInt(r.Headers, "X-Ratelimit-Perday-Remaining")
+	info.DayReset = headerSeconds(r.Headers, "X-Ratelimit-Perday-Reset")
+	info.RetryAfter = headerSeconds(r.Headers, "Retry-After")
+	return info
+}
+
+// Reads an integer header value, reporting whether it was present and valid
+func headerInt(headers http.Header, key string) (int64, bool) {
+	v, err := strconv.ParseInt(headers.Get(key), 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return v, true
+}
+
+// Reads a header value holding a number of seconds
+func headerSeconds(headers http.Header, key string) time.Duration {
+	if v, ok := headerInt(headers, key); ok && v > 0 {
+		return time.Duration(v) * time.Second
+	}
+	return 0
+}
+
+// Configuration for a RateLimitedClient; zero values fall back to the defaults noted
+type RateLimitOptions struct {
+	// Number of times a request is retried after a 429 or 5xx response (default 3, negative disables retries)
+	MaxRetries int
+	// Also retry POST and PUT requests after a 5xx response; off by default since the server may have
+	// acted on the request before failing, so retrying could create duplicate posts. 429s are always retried
+	RetryNonIdempotent bool
+	// Initial backoff delay, doubled on each retry (default 1s)
+	BaseDelay time.Duration
+	// Upper bound on a single backoff delay (default 1m)
+	MaxDelay time.Duration
+	// Hourly/daily quota to keep in reserve; once remaining reaches it, requests wait for the window to reset
+	ReserveQuota int64
+	// Longest a request will wait for a quota window to reset before failing with ErrRateLimited (default: no limit)
+	MaxQuotaWait time.Duration
+}
+
+// Counters describing a RateLimitedClient's activity
+type RateLimitStats struct {
+	// Requests issued to the wrapped client, including retries
+	Requests uint64
+	// Requests which were retried
+	Retries uint64
+	// 429 responses seen
+	RateLimited uint64
+	// 5xx responses seen
+	ServerErrors uint64
+	// Requests which waited for a quota window to reset
+	QuotaWaits uint64
+	// Total time spent sleeping for backoff or quota resets
+	TotalWait time.Duration
+	// Most recent rate limit headers seen
+	Last RateLimitInfo
+}
+
+// ClientInterface middleware which tracks the remaining quota, waits for it to reset when exhausted,
+// and retries 429s (and 5xx responses to GET, HEAD and DELETE requests) with jittered exponential backoff
+type RateLimitedClient struct {
+	client ClientInterface
+	options RateLimitOptions
+	mu sync.Mutex
+	stats RateLimitStats
+	// time before which no requests should be issued because the quota is exhausted
+	blockedUntil time.Time
+	// replaceable for tests
+	now func() time.Time
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+// Wraps the client with rate limit tracking and retries
+func NewRateLimitedClient(client ClientInterface, options RateLimitOptions) *RateLimitedClient {
+	if options.MaxRetries == 0 {
+		options.MaxRetries = 3
+	}
+	if options.BaseDelay <= 0 {
+		options.BaseDelay = time.Second
+	}
+	if options.MaxDelay <= 0 {
+		options.MaxDelay = time.Minute
+	}
+	return &RateLimitedClient{
+		client: client,
+		options: options,
+		now: time.Now,
+		sleep: sleepContext,
+	}
+}
+
+// Returns a snapshot of the client's counters
+func (c *RateLimitedClient) Stats() RateLimitStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stats
+}
+
+// Issue GET request to Tumblr API
+func (c *RateLimitedClient) Get(endpoint string) (Response, error) {
+	return c.GetContext(context.Background(), endpoint)
+}
+
+// Issue GET request to Tumblr API with param values
+func (c *RateLimitedClient) GetWithParams(endpoint string, params url.Values) (Response, error) {
+	return c.GetWithParamsContext(context.Background(), endpoint, params)
+}
+
+// Issue POST request to Tumblr API
+func (c *RateLimitedClient) Post(endpoint string) (Response, error) {
+	return c.PostContext(context.Background(), endpoint)
+}
+
+// Issue POST request to Tumblr API with param values
+func (c *RateLimitedClient) PostWithParams(endpoint string, params url.Values) (Response, error) {
+	return c.PostWithParamsContext(context.Background(), endpoint, params)
+}
+
+// Issue PUT request to Tumblr API
+func (c *RateLimitedClient) Put(endpoint string) (Response, error) {
+	return c.PutContext(context.Background(), endpoint)
+}
+
+// Issue PUT request to Tumblr API with param values
+func (c *RateLimitedClient) PutWithParams(endpoint string, params url.Values) (Response, error) {
+	return c.PutWithParamsContext(context.Background(), endpoint, params)
+}
+
+// Issue DELETE request to Tumblr API
+func (c *RateLimitedClient) Delete(endpoint string) (Response, error) {
+	return c.DeleteContext(context.Background(), endpoint)
+}
+
+// Issue DELETE request to Tumblr API with param values
+func (c *RateLimitedClient) DeleteWithParams(endpoint string, params url.Values) (Response, error) {
+	return c.DeleteWithParamsContext(context.Background(), endpoint, params)
+}
+
+// Issue GET request to Tumblr API, aborted if ctx is done
+func (c *RateLimitedClient) GetContext(ctx context.Context, endpoint string) (Response, error) {
+	return c.request(ctx, http.MethodGet, endpoint, nil)
+}
+
+// Issue GET request to Tumblr API with param values, aborted if ctx is done
+func (c *RateLimitedClient) GetWithParamsContext(ctx context.Context, endpoint string, params url.Values) (Response, error) {
+	return c.request(ctx, http.MethodGet, endpoint, params)
+}
+
+// Issue POST request to Tumblr API, aborted if ctx is done
+func (c *RateLimitedClient) PostContext(ctx context.Context, endpoint string) (Response, error) {
+	return c.request(ctx, http.MethodPost, endpoint, nil)
+}
+
+// Issue POST request to Tumblr API with param values, aborted if ctx is done
+func (c *RateLimitedClient) PostWithParamsContext(ctx context.Context, endpoint string, params url.Values) (Response, error) {
+	return c.request(ctx, http.MethodPost, endpoint, params)
+}
+
+// Issue PUT request to Tumblr API, aborted if ctx is done
+func (c *RateLimitedClient) PutContext(ctx context.Context, endpoint string) (Response, error) {
+	return c.request(ctx, http.MethodPut, endpoint, nil)
+}
+
+// Issue PUT request to Tumblr API with param values, aborted if ctx is done
+func (c *RateLimitedClient) PutWithParamsContext(ctx context.Context, endpoint string, params url.Values) (Response, error) {
+	return c.request(ctx, http.MethodPut, endpoint, params)
+}
+
+// Issue DELETE request to Tumblr API, aborted if ctx is done
+func (c *RateLimitedClient) DeleteContext(ctx context.Context, endpoint string) (Response, error) {
+	return c.request(ctx, http.MethodDelete, endpoint, nil)
+}
+
+// Issue DELETE request to Tumblr API with param values, aborted if ctx is done
+func (c *RateLimitedClient) DeleteWithParamsContext(ctx context.Context, endpoint string, params url.Values) (Response, error) {
+	return c.request(ctx, http.MethodDelete, endpoint, params)
+}
+
+// Sends the body through the wrapped client, which must implement BodyClientInterface, with the same retries as other requests
+func (c *RateLimitedClient) SendBody(ctx context.Context, method, endpoint string, body RequestBody) (Response, error) {
+	client, ok := c.client.(BodyClientInterface)
+	if !ok {
+		return Response{}, BodyNotSupportedError
+	}
+	return c.retry(ctx, method, func() (Response, error) {
+		return client.SendBody(ctx, method, endpoint, body)
+	})
+}
+
+// Issues the request through the wrapped client, waiting out exhausted quotas and retrying retryable failures
+func (c *RateLimitedClient) request(ctx context.Context, method, endpoint string, params url.Values) (Response, error) {
+	return c.retry(ctx, method, func() (Response, error) {
+		return sendRequest(ctx, c.client, method, endpoint, params)
+	})
+}
+
+// Runs attempt until it succeeds, fails with a non-retryable error, or retries are exhausted
+func (c *RateLimitedClient) retry(ctx context.Context, method string, attempt func() (Response, error)) (Response, error) {
+	for retries := 0; ; retries++ {
+		if err := c.waitForQuota(ctx); err != nil {
+			return Response{}, err
+		}
+		response, err := attempt()
+		status := c.record(response, err)
+		retryable := status == http.StatusTooManyRequests || status >= 500 && c.retriesServerErrors(method)
+		if !retryable || retries >= c.options.MaxRetries {
+			return response, err
+		}
+		delay := c.backoff(retries)
+		if retryAfter := response.RateLimit().RetryAfter; retryAfter > 0 {
+			delay = retryAfter
+			if delay > c.options.MaxDelay {
+				delay = c.options.MaxDelay
+			}
+		}
+		c.mu.Lock()
+		c.stats.Retries++
+		c.stats.TotalWait += delay
+		c.mu.Unlock()
+		if err := c.sleep(ctx, delay); err != nil {
+			return response, err
+		}
+	}
+}
+
+// Whether a request with the given method may be retried after a 5xx response
+func (c *RateLimitedClient) retriesServerErrors(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodDelete:
+		return true
+	}
+	return c.options.RetryNonIdempotent
+}
+
+// Updates the counters and quota state from a response, returning its failure status (0 if successful)
+func (c *RateLimitedClient) record(response Response, err error) int {
+	status := 0
+	apiErr := &APIError{}
+	if errors.As(err, &apiErr) {
+		status = apiErr.StatusCode
+	} else if err == nil {
+		if errors.As(checkResponse(response), &apiErr) {
+			status = apiErr.StatusCode
+		}
+	}
+	info := response.RateLimit()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.stats.Requests++
+	if status == http.StatusTooManyRequests {
+		c.stats.RateLimited++
+	} else if status >= 500 {
+		c.stats.ServerErrors++
+	}
+	if !info.HasHourly && !info.HasDaily {
+		return status
+	}
+	c.stats.Last = info
+	now := c.now()
+	if info.HasHourly && info.HourRemaining <= c.options.ReserveQuota {
+		c.blockUntil(now.Add(info.HourReset))
+	}
+	if info.HasDaily && info.DayRemaining <= c.options.ReserveQuota {
+		c.blockUntil(now.Add(info.DayReset))
+	}
+	return status
+}
+
+// Extends the blocked window; expects the mutex to be held
+func (c *RateLimitedClient) blockUntil(t time.Time) {
+	if t.After(c.blockedUntil) {
+		c.blockedUntil = t
+	}
+}
+
+// Sleeps until the exhausted quota window resets, if there is one
+func (c *RateLimitedClient) waitForQuota(ctx context.Context) error {
+	c.mu.Lock()
+	wait := c.blockedUntil.Sub(c.now())
+	if wait <= 0 {
+		c.mu.Unlock()
+		return nil
+	}
+	if c.options.MaxQuotaWait > 0 && wait > c.options.MaxQuotaWait {
+		c.mu.Unlock()
+		return &APIError{StatusCode: http.StatusTooManyRequests, Message: "Quota exhausted until " + c.blockedUntil.Format(time.RFC3339)}
+	}
+	c.stats.QuotaWaits++
+	c.stats.TotalWait += wait
+	c.mu.Unlock()
+	return c.sleep(ctx, wait)
+}
+
+// Exponential backoff with jitter: a random delay between half and all of BaseDelay * 2^retries, capped at MaxDelay
+func (c *RateLimitedClient) backoff(retries int) time.Duration {
+	delay := c.options.BaseDelay << uint(retries)
+	if delay <= 0 || delay > c.options.MaxDelay {
+		delay = c.options.MaxDelay
+	}
+	half := delay / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// Sleeps for the duration, returning early with the context's error if it is done first
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,185 @@
+package tumblr
+
+import (
+	"testing"
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+func rateLimitHeaders(hourRemaining, hourReset, dayRemaining, dayReset string) http.Header {
+	headers := http.Header{}
+	headers.Set("X-Ratelimit-Perhour-Limit", "1000")
+	headers.Set("X-Ratelimit-Perhour-Remaining", hourRemaining)
+	headers.Set("X-Ratelimit-Perhour-Reset", hourReset)
+	headers.Set("X-Ratelimit-Perday-Limit", "5000")
+	headers.Set("X-Ratelimit-Perday-Remaining", dayRemaining)
+	headers.Set("X-Ratelimit-Perday-Reset", dayReset)
+	return headers
+}
+
+// Creates a rate limited client over a test client whose responses are served in sequence
+func newSequencedRateLimitedClient(responses ...Response) (*RateLimitedClient, *testClient, *[]time.Duration) {
+	client := newTestClient("{}", nil)
+	calls := 0
+	client.confirmExpectedSet = func(method, path string, params url.Values) {
+		client.response = responses[calls]
+		if calls < len(responses) - 1 {
+			calls++
+		}
+	}
+	slept := []time.Duration{}
+	rl := NewRateLimitedClient(client, RateLimitOptions{BaseDelay: time.Second, MaxDelay: 4 * time.Second})
+	now := time.Unix(1000, 0)
+	rl.now = func() time.Time {
+		return now
+	}
+	rl.sleep = func(ctx context.Context, d time.Duration) error {
+		slept = append(slept, d)
+		now = now.Add(d)
+		return ctx.Err()
+	}
+	return rl, client, &slept
+}
+
+func TestResponse_RateLimit(t *testing.T) {
+	r := NewResponse([]byte("{}"), rateLimitHeaders("12", "60", "345", "3600"))
+	r.Headers.Set("Retry-After", "5")
+	info := r.RateLimit()
+	if !info.HasHourly || info.HourLimit != 1000 || info.HourRemaining != 12 || info.HourReset != time.Minute {
+		t.Fatal("Hourly rate limit not parsed correctly", info)
+	}
+	if !info.HasDaily || info.DayLimit != 5000 || info.DayRemaining != 345 || info.DayReset != time.Hour {
+		t.Fatal("Daily rate limit not parsed correctly", info)
+	}
+	if info.RetryAfter != 5 * time.Second {
+		t.Fatal("Retry-After not parsed correctly")
+	}
+	if info := NewResponse([]byte("{}"), nil).RateLimit(); info.HasHourly || info.HasDaily {
+		t.Fatal("Missing headers should not report limits")
+	}
+}
+
+func TestRateLimitedClientRetries(t *testing.T) {
+	limited := Response{body: []byte(`{"meta": {"status": 429, "msg": "Limit Exceeded"}}`)}
+	unavailable := Response{body: []byte(`{"meta": {"status": 503, "msg": "Service Unavailable"}}`)}
+	ok := Response{body: []byte(`{"meta": {"status": 200, "msg": "OK"}, "response": {"user": {"name": "david"}}}`)}
+	rl, _, slept := newSequencedRateLimitedClient(limited, unavailable, ok)
+	user, err := GetUserInfo(rl)
+	if err != nil || user.Name != "david" {
+		t.Fatal("Request should succeed after retrying", err)
+	}
+	if len(*slept) != 2 {
+		t.Fatal("Expected a backoff before each retry", *slept)
+	}
+	if (*slept)[0] < 500 * time.Millisecond || (*slept)[0] > time.Second || (*slept)[1] < time.Second || (*slept)[1] > 2 * time.Second {
+		t.Fatal("Backoff should grow exponentially with jitter", *slept)
+	}
+	stats := rl.Stats()
+	if stats.Requests != 3 || stats.Retries != 2 || stats.RateLimited != 1 || stats.ServerErrors != 1 {
+		t.Fatal("Stats not tracked correctly", stats)
+	}
+}
+
+func TestRateLimitedClientGivesUp(t *testing.T) {
+	limited := Response{body: []byte(`{"meta": {"status": 429, "msg": "Limit Exceeded"}}`), Headers: http.Header{}}
+	limited.Headers.Set("Retry-After", "3")
+	rl, _, slept := newSequencedRateLimitedClient(limited)
+	if _, err := GetUserInfo(rl); !errors.Is(err, ErrRateLimited) {
+		t.Fatal("Rate limit error should be returned once retries are exhausted", err)
+	}
+	if len(*slept) != 3 || (*slept)[0] != 3 * time.Second {
+		t.Fatal("Retry-After should be used instead of backoff", *slept)
+	}
+	limited.Headers.Set("Retry-After", "30")
+	rl, _, slept = newSequencedRateLimitedClient(limited)
+	GetUserInfo(rl)
+	if len(*slept) != 3 || (*slept)[0] != 4 * time.Second {
+		t.Fatal("Retry-After should be capped at MaxDelay", *slept)
+	}
+}
+
+func TestRateLimitedClientDoesNotRetryNonIdempotentServerErrors(t *testing.T) {
+	unavailable := Response{body: []byte(`{"meta": {"status": 502, "msg": "Bad Gateway"}}`)}
+	ok := Response{body: []byte(`{"meta": {"status": 201, "msg": "Created"}, "response": {"id": 1986}}`)}
+	rl, _, slept := newSequencedRateLimitedClient(unavailable, ok)
+	if _, err := CreatePost(rl, "david", url.Values{"body": []string{"Hello"}}); err == nil {
+		t.Fatal("Server error should be returned")
+	}
+	if len(*slept) != 0 || rl.Stats().Requests != 1 {
+		t.Fatal("POST requests should not be retried after a server error", rl.Stats())
+	}
+	rl, _, _ = newSequencedRateLimitedClient(unavailable, ok)
+	rl.options.RetryNonIdempotent = true
+	if ref, err := CreatePost(rl, "david", url.Values{"body": []string{"Hello"}}); err != nil || ref.Id != 1986 {
+		t.Fatal("RetryNonIdempotent should allow retrying POST requests", err)
+	}
+}
+
+func TestRateLimitedClientDoesNotRetryClientErrors(t *testing.T) {
+	notFound := Response{body: []byte(`{"meta": {"status": 404, "msg": "Not Found"}}`)}
+	rl, _, slept := newSequencedRateLimitedClient(notFound)
+	if _, err := GetBlogInfo(rl, "david"); !errors.Is(err, ErrNotFound) {
+		t.Fatal("Non-retryable error should be returned", err)
+	}
+	if len(*slept) != 0 || rl.Stats().Requests != 1 {
+		t.Fatal("Non-retryable errors should not be retried")
+	}
+}
+
+func TestRateLimitedClientWaitsForQuota(t *testing.T) {
+	exhausted := Response{body: []byte("{}"), Headers: rateLimitHeaders("0", "120", "100", "3600")}
+	rl, _, slept := newSequencedRateLimitedClient(exhausted)
+	if _, err := rl.Get("/user/info"); err != nil {
+		t.Fatal("Request should succeed")
+	}
+	if len(*slept) != 0 {
+		t.Fatal("First request should not wait")
+	}
+	if _, err := rl.Get("/user/info"); err != nil {
+		t.Fatal("Request should succeed")
+	}
+	if len(*slept) != 1 || (*slept)[0] != 2 * time.Minute {
+		t.Fatal("Request should wait for the hourly quota to reset", *slept)
+	}
+	stats := rl.Stats()
+	if stats.QuotaWaits != 1 || stats.Last.HourRemaining != 0 {
+		t.Fatal("Quota wait not recorded", stats)
+	}
+	rl.options.MaxQuotaWait = time.Minute
+	if _, err := rl.Get("/user/info"); !errors.Is(err, ErrRateLimited) {
+		t.Fatal("Waits beyond MaxQuotaWait should fail", err)
+	}
+}
+
+func TestRateLimitedClientContext(t *testing.T) {
+	limited := Response{body: []byte(`{"meta": {"status": 429, "msg": "Limit Exceeded"}}`)}
+	rl, _, _ := newSequencedRateLimitedClient(limited)
+	ctx, cancel := context.WithCancel(context.Background())
+	rl.sleep = func(context.Context, time.Duration) error {
+		cancel()
+		return context.Canceled
+	}
+	if _, err := rl.GetContext(ctx, "/user/info"); err != context.Canceled {
+		t.Fatal("Cancelled context should abort the backoff", err)
+	}
+}
+
+func TestRateLimitedClientSendBody(t *testing.T) {
+	limited := Response{body: []byte(`{"meta": {"status": 429, "msg": "Limit Exceeded"}}`)}
+	ok := Response{body: []byte(`{"meta": {"status": 201, "msg": "Created"}, "response": {"id": "1986"}}`)}
+	rl, client, _ := newSequencedRateLimitedClient(limited, ok)
+	ref, err := CreateNPFPost(rl, "david", NPFPostRequest{Content: ContentBlocks{&TextBlock{Text: "Hello"}}})
+	if err != nil || ref.Id != 1986 {
+		t.Fatal("Body requests should be retried", err)
+	}
+	if client.body == nil || rl.Stats().Retries != 1 {
+		t.Fatal("Body should be sent through the wrapped client")
+	}
+	rl = NewRateLimitedClient(struct{ ClientInterface }{client}, RateLimitOptions{})
+	if _, err = rl.SendBody(context.Background(), http.MethodPost, "/path", client.body); err != BodyNotSupportedError {
+		t.Fatal("Wrapped clients without body support should generate an error", err)
+	}
+}
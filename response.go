@@ -1,7 +1,10 @@
 package tumblr
 
 import (
+	"bytes"
 	"encoding/json"
+	"fmt"
+	"strconv"
 	"errors"
 	"net/http"
 )
@@ -38,4 +41,46 @@ func (r *Response) PopulateFromBody() error {
 		return e
 	}
 	return nil
-}
\ No newline at end of file
+}
+
+// Pagination link from a response's `_links` object
+type Link struct {
+	Href string `json:"href"`
+	Method string `json:"method"`
+	QueryParams LinkParams `json:"query_params"`
+}
+
+// Query params of a pagination link; the API sends some values (eg timestamps) as numbers, so every value is decoded as its string form
+type LinkParams map[string]string
+
+// Decodes string, number and boolean values into their string form
+func (l *LinkParams) UnmarshalJSON(data []byte) error {
+	raw := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	params := make(LinkParams, len(raw))
+	for key, value := range raw {
+		str := ""
+		if err := json.Unmarshal(value, &str); err != nil {
+			var scalar interface{}
+			decoder := json.NewDecoder(bytes.NewReader(value))
+			decoder.UseNumber()
+			if err = decoder.Decode(&scalar); err != nil {
+				return err
+			}
+			switch v := scalar.(type) {
+			case json.Number:
+				str = v.String()
+			case bool:
+				str = strconv.FormatBool(v)
+			case nil:
+			default:
+				return fmt.Errorf("Unsupported value for link param %s: %s", key, value)
+			}
+		}
+		params[key] = str
+	}
+	*l = params
+	return nil
+}
@@ -2,6 +2,7 @@ package tumblr
 
 import (
 	"testing"
+	"encoding/json"
 	"net/http"
 )
 
@@ -47,4 +48,17 @@ func TestResponse_PopulateFromBody(t *testing.T) {
 	if err = r.PopulateFromBody(); err == nil {
 		t.Fatal("Populate from body should return unmarshal error on invalid JSON")
 	}
-}
\ No newline at end of file
+}
+func TestLinkParamsUnmarshal(t *testing.T) {
+	link := Link{}
+	data := `{"href": "/v2/user/likes?before=1506371380", "method": "GET", "query_params": {"before": 1506371380, "tag": "cats", "reblog_info": true, "mode": null}}`
+	if err := json.Unmarshal([]byte(data), &link); err != nil {
+		t.Fatal("Numeric link params should decode", err)
+	}
+	if link.QueryParams["before"] != "1506371380" || link.QueryParams["tag"] != "cats" || link.QueryParams["reblog_info"] != "true" || link.QueryParams["mode"] != "" {
+		t.Fatal("Link params should decode into their string form", link.QueryParams)
+	}
+	if err := json.Unmarshal([]byte(`{"query_params": {"before": [1]}}`), &link); err == nil {
+		t.Fatal("Non-scalar link params should generate an error")
+	}
+}
@@ -1,6 +1,8 @@
 package tumblr
 
 import (
+	"context"
+	"net/http"
 	"net/url"
 	"encoding/json"
 	"strconv"
@@ -8,37 +10,40 @@ import (
 
 type SearchResults struct {
 	client ClientInterface
-	Posts []PostInterface `json:"response"`
+	Posts PostList `json:"response"`
 	params url.Values
 }
 
 // gets page of posts
 func TaggedSearch(client ClientInterface, tag string, params url.Values) (*SearchResults, error) {
+	return TaggedSearchContext(context.Background(), client, tag, params)
+}
+
+// gets page of posts, aborting if ctx is done
+func TaggedSearchContext(ctx context.Context, client ClientInterface, tag string, params url.Values) (*SearchResults, error) {
 	params.Set("tag", tag)
-	response, err := client.GetWithParams("/tagged", params)
+	response, err := doRequest(ctx, client, http.MethodGet, "/tagged", params)
 	if err != nil {
 		return nil, err
 	}
-	result := struct {
-		Response []MiniPost `json:"response"`
-	}{}
-	if err = json.Unmarshal(response.body, &result); err != nil {
-		return nil, err
-	}
-	minis := result.Response
 	full := SearchResults{
-		Posts: makePostsFromMinis(minis, client),
 		client: client,
 		params: params,
 	}
 	if err = json.Unmarshal(response.body, &full); err != nil {
 		return nil, err
 	}
+	full.Posts.SetClient(client)
 	return &full, nil
 }
 
 // returns next page of results
 func (s *SearchResults) Next() (*SearchResults, error) {
+	return s.NextContext(context.Background())
+}
+
+// returns next page of results, aborting if ctx is done
+func (s *SearchResults) NextContext(ctx context.Context) (*SearchResults, error) {
 	// get last timestamp
 	var size = len(s.Posts)
 	if size < 1 {
@@ -51,5 +56,5 @@ func (s *SearchResults) Next() (*SearchResults, error) {
 	}
 	params := s.params
 	params.Set("before", strconv.FormatUint(lastTs, 10))
-	return TaggedSearch(s.client, params.Get("tag"), params)
+	return TaggedSearchContext(ctx, s.client, params.Get("tag"), params)
 }
\ No newline at end of file
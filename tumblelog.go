@@ -1,10 +1,11 @@
 package tumblr
 
 import (
-	"strings"
+	"context"
 	"errors"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"net/url"
 )
 
@@ -24,6 +25,52 @@ type ShortBlog struct {
 	PostToTwitter string `json:"tweet"`
 	PostToFacebook string `json:"facebook"`
 	Visibility string `json:"type"`
+	Uuid string `json:"uuid,omitempty"`
+	// Whether the user is an admin of the blog, rather than a member of a group blog
+	Admin bool `json:"admin"`
+	Description string `json:"description,omitempty"`
+	Avatar []AvatarImage `json:"avatar,omitempty"`
+	Theme *BlogTheme `json:"theme,omitempty"`
+	Posts int64 `json:"posts"`
+	TotalPosts int64 `json:"total_posts"`
+	Drafts int64 `json:"drafts"`
+	Queue int64 `json:"queue"`
+	// Number of unread asks and submissions in the blog's inbox
+	Messages int64 `json:"messages"`
+	Ask bool `json:"ask"`
+	AskAnon bool `json:"ask_anon"`
+	CanSendFanMail bool `json:"can_send_fan_mail"`
+	CanSubmit bool `json:"can_submit"`
+	IsNSFW bool `json:"is_nsfw"`
+	ShareLikes bool `json:"share_likes"`
+	Updated int64 `json:"updated"`
+}
+
+// Blog avatar at a single size
+type AvatarImage struct {
+	Width int `json:"width"`
+	Height int `json:"height"`
+	Url string `json:"url"`
+}
+
+// Blog substructure describing the blog's theme
+type BlogTheme struct {
+	AvatarShape string `json:"avatar_shape"`
+	BackgroundColor string `json:"background_color"`
+	BodyFont string `json:"body_font"`
+	HeaderBounds string `json:"header_bounds"`
+	HeaderImage string `json:"header_image"`
+	HeaderImageFocused string `json:"header_image_focused"`
+	HeaderImageScaled string `json:"header_image_scaled"`
+	HeaderStretch bool `json:"header_stretch"`
+	LinkColor string `json:"link_color"`
+	ShowAvatar bool `json:"show_avatar"`
+	ShowDescription bool `json:"show_description"`
+	ShowHeaderImage bool `json:"show_header_image"`
+	ShowTitle bool `json:"show_title"`
+	TitleColor string `json:"title_color"`
+	TitleFont string `json:"title_font"`
+	TitleFontWeight string `json:"title_font_weight"`
 }
 
 // Tumblelog struct
@@ -56,7 +103,12 @@ func (b *Blog) String() string {
 
 // Retrieve information about a blog
 func GetBlogInfo(client ClientInterface, name string) (*Blog, error) {
-	response, err := client.Get(blogPath("/blog/%s/info", name))
+	return GetBlogInfoContext(context.Background(), client, name)
+}
+
+// Retrieve information about a blog, aborting if ctx is done
+func GetBlogInfoContext(ctx context.Context, client ClientInterface, name string) (*Blog, error) {
+	response, err := doRequest(ctx, client, http.MethodGet, blogPath("/blog/%s/info", name), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -76,7 +128,12 @@ func GetBlogInfo(client ClientInterface, name string) (*Blog, error) {
 
 // Retrieve Blog's Avatar URI
 func GetAvatar(client ClientInterface, name string) (string, error) {
-	response, err := client.Get(blogPath("/blog/%s/avatar", name))
+	return GetAvatarContext(context.Background(), client, name)
+}
+
+// Retrieve Blog's Avatar URI, aborting if ctx is done
+func GetAvatarContext(ctx context.Context, client ClientInterface, name string) (string, error) {
+	response, err := doRequest(ctx, client, http.MethodGet, blogPath("/blog/%s/avatar", name), nil)
 	if err != nil {
 		return "", err
 	}
@@ -104,42 +161,132 @@ func NewBlogRef(client ClientInterface, name string) (*BlogRef) {
 
 // Retrieves blog info for the given blog reference
 func (b *BlogRef) GetInfo() (*Blog, error) {
-	return GetBlogInfo(b.client, b.Name)
+	return b.GetInfoContext(context.Background())
+}
+
+// Retrieves blog info for the given blog reference, aborting if ctx is done
+func (b *BlogRef) GetInfoContext(ctx context.Context) (*Blog, error) {
+	return GetBlogInfoContext(ctx, b.client, b.Name)
 }
 
 // Retrieves blog avatar for the given blog reference
 func (b *BlogRef) GetAvatar() (string, error) {
-	return GetAvatar(b.client, b.Name)
+	return b.GetAvatarContext(context.Background())
+}
+
+// Retrieves blog avatar for the given blog reference, aborting if ctx is done
+func (b *BlogRef) GetAvatarContext(ctx context.Context) (string, error) {
+	return GetAvatarContext(ctx, b.client, b.Name)
 }
 
 // Retrieves blog's followers for the given blog reference
 func (b *BlogRef) GetFollowers() (*FollowerList, error) {
-	return GetFollowers(b.client, b.Name, 0, 0)
+	return b.GetFollowersContext(context.Background())
+}
+
+// Retrieves blog's followers for the given blog reference, aborting if ctx is done
+func (b *BlogRef) GetFollowersContext(ctx context.Context) (*FollowerList, error) {
+	return GetFollowersContext(ctx, b.client, b.Name, 0, 0)
+}
+
+// Retrieves the blogs followed by the given blog reference
+func (b *BlogRef) GetFollowing() (*FollowingList, error) {
+	return b.GetFollowingContext(context.Background())
+}
+
+// Retrieves the blogs followed by the given blog reference, aborting if ctx is done
+func (b *BlogRef) GetFollowingContext(ctx context.Context) (*FollowingList, error) {
+	return GetBlogFollowingContext(ctx, b.client, b.Name, 0, 0)
+}
+
+// Checks whether the given blog reference is followed by the blog named by query
+func (b *BlogRef) FollowedBy(query string) (bool, error) {
+	return b.FollowedByContext(context.Background(), query)
+}
+
+// Checks whether the given blog reference is followed by the blog named by query, aborting if ctx is done
+func (b *BlogRef) FollowedByContext(ctx context.Context, query string) (bool, error) {
+	return FollowedByContext(ctx, b.client, b.Name, query)
 }
 
 // Retrieves blog's posts for the given blog reference
 func (b *BlogRef) GetPosts(params url.Values) (*Posts, error) {
-	return GetPosts(b.client, b.Name, params)
+	return b.GetPostsContext(context.Background(), params)
+}
+
+// Retrieves blog's posts for the given blog reference, aborting if ctx is done
+func (b *BlogRef) GetPostsContext(ctx context.Context, params url.Values) (*Posts, error) {
+	return GetPostsContext(ctx, b.client, b.Name, params)
+}
+
+// Retrieves a single post by ID from the given blog reference
+func (b *BlogRef) GetPost(postId uint64) (PostInterface, error) {
+	return b.GetPostContext(context.Background(), postId)
+}
+
+// Retrieves a single post by ID from the given blog reference, aborting if ctx is done
+func (b *BlogRef) GetPostContext(ctx context.Context, postId uint64) (PostInterface, error) {
+	return GetPostContext(ctx, b.client, b.Name, postId)
+}
+
+// Retrieves the posts liked by the given blog reference
+func (b *BlogRef) GetLikes(params url.Values) (*Likes, error) {
+	return b.GetLikesContext(context.Background(), params)
+}
+
+// Retrieves the posts liked by the given blog reference, aborting if ctx is done
+func (b *BlogRef) GetLikesContext(ctx context.Context, params url.Values) (*Likes, error) {
+	return GetBlogLikesContext(ctx, b.client, b.Name, params)
 }
 
 // Retrieves blog's queue for the given blog reference
 func (b *BlogRef) GetQueue(params url.Values) (*Posts, error) {
-	return GetQueue(b.client, b.Name, params)
+	return b.GetQueueContext(context.Background(), params)
+}
+
+// Retrieves blog's queue for the given blog reference, aborting if ctx is done
+func (b *BlogRef) GetQueueContext(ctx context.Context, params url.Values) (*Posts, error) {
+	return GetQueueContext(ctx, b.client, b.Name, params)
 }
 
 // Retrieves blog's drafts for the given blog reference
 func (b *BlogRef) GetDrafts(params url.Values) (*Posts, error) {
-	return GetDrafts(b.client, b.Name, params)
+	return b.GetDraftsContext(context.Background(), params)
+}
+
+// Retrieves blog's drafts for the given blog reference, aborting if ctx is done
+func (b *BlogRef) GetDraftsContext(ctx context.Context, params url.Values) (*Posts, error) {
+	return GetDraftsContext(ctx, b.client, b.Name, params)
 }
 
 // Creates a post on the blog represented by BlogRef
 func (b *BlogRef) CreatePost(params url.Values) (*PostRef, error) {
-	return CreatePost(b.client, b.Name, params)
+	return b.CreatePostContext(context.Background(), params)
+}
+
+// Creates a post on the blog represented by BlogRef, aborting if ctx is done
+func (b *BlogRef) CreatePostContext(ctx context.Context, params url.Values) (*PostRef, error) {
+	return CreatePostContext(ctx, b.client, b.Name, params)
+}
+
+// Creates a post from NPF content on the blog represented by BlogRef
+func (b *BlogRef) CreateNPFPost(post NPFPostRequest) (*PostRef, error) {
+	return b.CreateNPFPostContext(context.Background(), post)
+}
+
+// Creates a post from NPF content on the blog represented by BlogRef, aborting if ctx is done
+func (b *BlogRef) CreateNPFPostContext(ctx context.Context, post NPFPostRequest) (*PostRef, error) {
+	return CreateNPFPostContext(ctx, b.client, b.Name, post)
 }
 
 // Reblogs a post to the blog represented by BlogRef
 func (b *BlogRef) ReblogPost(p *PostRef, params url.Values) (*PostRef, error) {
-	return p.ReblogOnBlog(b.Name, params)
+	return b.ReblogPostContext(context.Background(), p, params)
+}
+
+// Reblogs a post to the blog represented by BlogRef, aborting if ctx is done
+func (b *BlogRef) ReblogPostContext(ctx context.Context, p *PostRef, params url.Values) (*PostRef, error) {
+	return p.ReblogOnBlogContext(ctx, b.Name, params)
 }
 
 // Retrieves name property
@@ -149,23 +296,38 @@ func (b *BlogRef) getName() string {
 
 // Follows this blog for the current user (based on OAuth user token/secret)
 func (b *BlogRef) Follow() error {
-	return Follow(b.client, b.getName())
+	return b.FollowContext(context.Background())
+}
+
+// Follows this blog for the current user, aborting if ctx is done
+func (b *BlogRef) FollowContext(ctx context.Context) error {
+	return FollowContext(ctx, b.client, b.getName())
 }
 
 // Unfollows this blog for the current user (based on OAuth user token/secret)
 func (b *BlogRef) Unfollow() error {
-	return Unfollow(b.client, b.getName())
+	return b.UnfollowContext(context.Background())
 }
 
-// Helper function to allow for less verbose code
+// Unfollows this blog for the current user, aborting if ctx is done
+func (b *BlogRef) UnfollowContext(ctx context.Context) error {
+	return UnfollowContext(ctx, b.client, b.getName())
+}
+
+// Hostname form of a blog name, URL or UUID, for params such as Follow's `url`; unparseable names are passed through as-is
 func normalizeBlogName(name string) string {
-	if !strings.Contains(name, ".") {
-		name = fmt.Sprintf("%s.tumblr.com", name)
+	id, err := ParseBlogIdentifier(name)
+	if err != nil {
+		return name
 	}
-	return name
+	return id.Hostname()
 }
 
-// Expects path to contain a single %s placeholder to be substituted with the result of normalizeBlogName
+// Expects path to contain a single %s placeholder to be substituted with the blog's identifier,
+// which may be a name, hostname, URL or UUID; unparseable names are passed through as-is
 func blogPath(path, name string) string {
-	return fmt.Sprintf(path, normalizeBlogName(name))
+	if id, err := ParseBlogIdentifier(name); err == nil {
+		name = id.String()
+	}
+	return fmt.Sprintf(path, name)
 }
\ No newline at end of file
@@ -1,10 +1,14 @@
 package tumblr
 
 import (
+	"context"
 	"encoding/json"
+	"net/http"
+	"time"
 )
 
 type User struct {
+	client ClientInterface
 	Following uint32 `json:"following"`
 	DefaultPostFormat string `json:"default_post_format"`
 	Name string `json:"name"`
@@ -14,7 +18,12 @@ type User struct {
 
 // Retrieves the current user's info (based on the client's token/secret values)
 func GetUserInfo(client ClientInterface) (*User, error) {
-	response, err := client.Get("/user/info")
+	return GetUserInfoContext(context.Background(), client)
+}
+
+// Retrieves the current user's info, aborting if ctx is done
+func GetUserInfoContext(ctx context.Context, client ClientInterface) (*User, error) {
+	response, err := doRequest(ctx, client, http.MethodGet, "/user/info", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -26,5 +35,66 @@ func GetUserInfo(client ClientInterface) (*User, error) {
 	if err = json.Unmarshal(response.body, &result); err != nil {
 		return nil, err
 	}
+	result.Response.User.client = client
+	for i := range result.Response.User.Blogs {
+		result.Response.User.Blogs[i].client = client
+	}
+	return &result.Response.User, nil
+}
+
+// Remaining quota of a single kind of action
+type UserLimit struct {
+	Description string `json:"description"`
+	Limit int64 `json:"limit"`
+	Remaining int64 `json:"remaining"`
+	// Unix timestamp at which the quota resets
+	ResetAt int64 `json:"reset_at"`
+}
+
+// Time at which the quota resets
+func (l UserLimit) ResetTime() time.Time {
+	return time.Unix(l.ResetAt, 0)
+}
+
+// The user's daily quotas for creating content and following blogs
+type UserLimits struct {
+	Blogs UserLimit `json:"blogs"`
+	Follows UserLimit `json:"follows"`
+	Likes UserLimit `json:"likes"`
+	Photos UserLimit `json:"photos"`
+	Posts UserLimit `json:"posts"`
+	VideoSeconds UserLimit `json:"video_seconds"`
+	Videos UserLimit `json:"videos"`
+}
+
+// Retrieves the current user's remaining quotas
+func GetUserLimits(client ClientInterface) (*UserLimits, error) {
+	return GetUserLimitsContext(context.Background(), client)
+}
+
+// Retrieves the current user's remaining quotas, aborting if ctx is done
+func GetUserLimitsContext(ctx context.Context, client ClientInterface) (*UserLimits, error) {
+	response, err := doRequest(ctx, client, http.MethodGet, "/user/limits", nil)
+	if err != nil {
+		return nil, err
+	}
+	result := struct{
+		Response struct {
+			User UserLimits `json:"user"`
+		} `json:"response"`
+	}{}
+	if err = json.Unmarshal(response.body, &result); err != nil {
+		return nil, err
+	}
 	return &result.Response.User, nil
 }
+
+// Retrieves the user's remaining quotas
+func (u *User) GetLimits() (*UserLimits, error) {
+	return u.GetLimitsContext(context.Background())
+}
+
+// Retrieves the user's remaining quotas, aborting if ctx is done
+func (u *User) GetLimitsContext(ctx context.Context) (*UserLimits, error) {
+	return GetUserLimitsContext(ctx, u.client)
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"net/http"
 	"net/url"
+	"time"
 )
 
 func TestGetUserInfoClientError(t *testing.T) {
@@ -33,3 +34,55 @@ func TestGetUserInfo(t *testing.T) {
 		t.Fatal("User info failed")
 	}
 }
+
+func TestGetUserInfoBlogs(t *testing.T) {
+	client := newTestClient(`{"response": {"user": {"name": "david", "likes": 5, "blogs": [{
+		"name": "david",
+		"uuid": "t:abc",
+		"admin": true,
+		"primary": true,
+		"queue": 3,
+		"drafts": 2,
+		"messages": 1,
+		"avatar": [{"width": 64, "height": 64, "url": "https://example.com/64.png"}],
+		"theme": {"avatar_shape": "circle", "header_stretch": true, "title_color": "#444444"}
+	}]}}}`, nil)
+	user, err := GetUserInfo(client)
+	if err != nil || len(user.Blogs) != 1 {
+		t.Fatal("Failed to get user info", err)
+	}
+	blog := user.Blogs[0]
+	if blog.Uuid != "t:abc" || !blog.Admin || !blog.IsPrimary || blog.Queue != 3 || blog.Drafts != 2 || blog.Messages != 1 {
+		t.Fatal("Blog fields not decoded", blog)
+	}
+	if len(blog.Avatar) != 1 || blog.Avatar[0].Width != 64 || blog.Theme == nil || blog.Theme.AvatarShape != "circle" || !blog.Theme.HeaderStretch {
+		t.Fatal("Blog avatar and theme not decoded", blog)
+	}
+	if blog.client != client {
+		t.Fatal("User's blogs should be usable as BlogRefs")
+	}
+}
+
+func TestGetUserLimits(t *testing.T) {
+	client := newTestClient(`{"response": {"user": {
+		"posts": {"description": "Total posts created", "limit": 250, "remaining": 200, "reset_at": 1600000000},
+		"follows": {"limit": 200, "remaining": 0, "reset_at": 1600000100},
+		"video_seconds": {"limit": 600, "remaining": 600, "reset_at": 1600000000}
+	}}}`, nil)
+	client.confirmExpectedSet = expectClientCallParams(t, "GetUserLimits", http.MethodGet, "/user/limits", url.Values{})
+	limits, err := GetUserLimits(client)
+	if err != nil {
+		t.Fatal("Failed to get user limits", err)
+	}
+	if limits.Posts.Limit != 250 || limits.Posts.Remaining != 200 || limits.Posts.Description == "" || limits.VideoSeconds.Limit != 600 {
+		t.Fatal("Limits not decoded correctly", limits)
+	}
+	if limits.Follows.Remaining != 0 || !limits.Follows.ResetTime().Equal(time.Unix(1600000100, 0)) {
+		t.Fatal("Reset time not decoded correctly", limits.Follows)
+	}
+	user := &User{}
+	user.SetClient(newTestClient("{", nil))
+	if _, err = user.GetLimits(); err == nil {
+		t.Fatal("Invalid JSON should generate an error")
+	}
+}